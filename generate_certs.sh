@@ -0,0 +1,32 @@
+#!/bin/sh
+# Generates a local CA, a server certificate for localhost and a client
+# certificate signed by it into certs/, to try TLS and mutual TLS:
+#
+#   go run ./ws_server -tls-cert certs/server.pem -tls-key certs/server-key.pem -tls-client-ca certs/ca.pem
+#   go run ./ws_client -tls-ca certs/ca.pem -tls-cert certs/client.pem -tls-key certs/client-key.pem
+#
+# Running it again replaces the certificates, which the server reloads.
+set -e
+mkdir -p certs
+cd certs
+
+# the CA
+openssl req -x509 -newkey rsa:2048 -nodes -days 365 \
+    -keyout ca-key.pem -out ca.pem -subj "/CN=wordsearcher local CA"
+
+# the server, valid for localhost
+openssl req -newkey rsa:2048 -nodes \
+    -keyout server-key.pem -out server.csr -subj "/CN=localhost"
+printf "subjectAltName=DNS:localhost,IP:127.0.0.1,IP:::1\nextendedKeyUsage=serverAuth\n" > server.ext
+openssl x509 -req -in server.csr -CA ca.pem -CAkey ca-key.pem -CAcreateserial \
+    -days 365 -out server.pem -extfile server.ext
+
+# a client for mutual TLS
+openssl req -newkey rsa:2048 -nodes \
+    -keyout client-key.pem -out client.csr -subj "/CN=wordsearcher client"
+printf "extendedKeyUsage=clientAuth\n" > client.ext
+openssl x509 -req -in client.csr -CA ca.pem -CAkey ca-key.pem -CAcreateserial \
+    -days 365 -out client.pem -extfile client.ext
+
+rm -f server.csr server.ext client.csr client.ext ca.srl
+echo "Certificates written to certs/"
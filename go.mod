@@ -4,6 +4,9 @@ go 1.14
 
 require (
 	go.mongodb.org/mongo-driver v1.5.2
+	golang.org/x/crypto v0.0.0-20200302210943-78000ba7a073
+	golang.org/x/text v0.3.5
 	google.golang.org/grpc v1.37.1
 	google.golang.org/protobuf v1.26.0
+	gopkg.in/yaml.v2 v2.2.8
 )
@@ -2,17 +2,36 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
 	"fmt"
 	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"io/ioutil"
 	"log"
+	"strings"
 	"time"
 )
 
 func main() {
+	address := flag.String("address", "localhost:50051", "address of the server")
+	caFile := flag.String("tls-ca", "", "PEM CA of the server certificate, turns on TLS")
+	certFile := flag.String("tls-cert", "", "PEM client certificate for mutual TLS")
+	keyFile := flag.String("tls-key", "", "PEM private key of the client certificate")
+	serverName := flag.String("tls-server-name", "", "name the server certificate must have, the host of the address by default")
+	flag.Parse()
+
 	fmt.Println("Starting the client!")
 
-	cc, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
+	transport, err := transportCredentials(*caFile, *certFile, *keyFile, *serverName)
+	if err != nil {
+		log.Fatalf("Failed to load the TLS files: %v", err)
+	}
+	cc, err := grpc.Dial(*address, transport)
 	if err != nil {
 		log.Fatalf("Failed to connect to the server: %v", err)
 	}
@@ -34,6 +53,56 @@ func main() {
 	doBookRange(c)
 	doChapterRange(c)
 	doCustomRange(c)
+	//doCrossReferences(c)
+	//doStrongsSearch(c)
+	//doLexicon(c)
+	//doInterlinear(c)
+	//doGreekSearch(c)
+	//doRedLetterSearch(c)
+	//doPericopes(c)
+	//doFootnoteSearch(c)
+	//doImportPlan(c)
+	//doExportPlan(c)
+	//doGeneratePlan(c)
+	//doGenerateTrackPlan(c)
+	//doRegisterAndLogin(c)
+	//doPlanProgress(c)
+	//doAnnotations(c)
+	//doVerseOfTheDay(c)
+	//doMemorize(c)
+	//doWordSearchPuzzle(c)
+	//doQuiz(c)
+	//doHealthCheck(cc)
+}
+
+// transportCredentials dials in plaintext without a CA, and with TLS
+// verifying the server against the CA otherwise, presenting the client
+// certificate when the server wants mutual TLS
+func transportCredentials(caFile, certFile, keyFile, serverName string) (grpc.DialOption, error) {
+	if caFile == "" {
+		return grpc.WithInsecure(), nil
+	}
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	config := &tls.Config{
+		RootCAs:    roots,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
 }
 
 func doVerseUnary(c wordsearcher.WordsearcherServiceClient) {
@@ -105,8 +174,9 @@ func doBiblePlanCall(c wordsearcher.WordsearcherServiceClient) {
 func doBiblePlanDayCall(c wordsearcher.WordsearcherServiceClient) {
 	fmt.Println("Starting to do a Bible Plan Day gRPC...")
 
+	// days are 1 based, 0 would also ask for today
 	t := time.Now()
-	cDay := t.YearDay() - 1
+	cDay := t.YearDay()
 	req := &wordsearcher.BiblePlanDayRequest{
 		Name: "McCheyneBasedYearly",
 		Day:  int32(cDay),
@@ -187,3 +257,503 @@ func doCustomRange(c wordsearcher.WordsearcherServiceClient) {
 
 	fmt.Printf("\nResponse from server: Number of Books Found: %d;", len(res.GetCustomRange().Customrange))
 }
+
+func doCrossReferences(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a Cross References gRPC...")
+
+	req := &wordsearcher.CrossReferencesRequest{
+		Book:        43,
+		Chapter:     3,
+		VerseStart:  16,
+		IncludeText: true,
+		Limit:       5,
+	}
+
+	res, err := c.CrossReferences(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	fmt.Printf("\nResponse from server: Number of Cross References Found: %d;", len(res.GetCrossReferences()))
+	for _, ref := range res.GetCrossReferences() {
+		fmt.Printf("\n%s (%d votes): %d verses", ref.GetReference(), ref.GetVotes(), len(ref.GetVerses()))
+	}
+}
+
+func doStrongsSearch(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a Strong's Search gRPC...")
+
+	req := &wordsearcher.SearchRequest{
+		Term:     "H2617",
+		Filter:   "strongs",
+		Location: "ot",
+	}
+
+	res, err := c.Search(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	fmt.Printf("\nResponse from server: Number of Verses Found: %d;", len(res.GetVerses()))
+}
+
+func doLexicon(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a Lexicon gRPC...")
+
+	req := &wordsearcher.LexiconRequest{
+		Strongs: "G26",
+	}
+
+	res, err := c.Lexicon(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	entry := res.GetEntry()
+	fmt.Printf("\nResponse from server: %s %s (%s) - %s; %d occurrences",
+		entry.GetStrongs(), entry.GetLemma(), entry.GetTransliteration(), entry.GetDefinition(), entry.GetOccurrences())
+}
+
+func doInterlinear(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do an Interlinear gRPC...")
+
+	req := &wordsearcher.InterlinearRequest{
+		Book:       43,
+		Chapter:    1,
+		VerseStart: 1,
+		VerseEnd:   1,
+	}
+
+	res, err := c.Interlinear(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	for _, verse := range res.GetVerses() {
+		fmt.Printf("\n%d:%d (%s)", verse.GetChapter(), verse.GetVerse(), verse.GetSource())
+		for _, token := range verse.GetTokens() {
+			fmt.Printf("\n  %s %s %s %s - %s", token.GetText(), token.GetLemma(), token.GetStrongs(), token.GetMorph(), token.GetGloss())
+		}
+	}
+}
+
+func doGreekSearch(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a Greek Search gRPC...")
+
+	// unaccented query against the accented SBLGNT text
+	req := &wordsearcher.SearchRequest{
+		Term:        "αγαπη",
+		Translation: "SBLGNT",
+	}
+
+	res, err := c.Search(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	fmt.Printf("\nResponse from server: Number of Verses Found: %d;", len(res.GetVerses()))
+}
+
+func doRedLetterSearch(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a Words of Christ Search gRPC...")
+
+	req := &wordsearcher.SearchRequest{
+		Term:          "kingdom",
+		WordsOfChrist: true,
+	}
+
+	res, err := c.Search(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	fmt.Printf("\nResponse from server: Number of Verses Found: %d;", len(res.GetVerses()))
+	if len(res.GetVerses()) > 0 {
+		fmt.Printf("\nFirst verse markup: %v", res.GetVerses()[0].GetSpans())
+	}
+}
+
+func doPericopes(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a Pericopes gRPC...")
+
+	req := &wordsearcher.PericopesRequest{
+		Book: 40,
+	}
+
+	res, err := c.Pericopes(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	for _, pericope := range res.GetPericopes() {
+		fmt.Printf("\n%s - %s", pericope.GetReference(), pericope.GetTitle())
+	}
+}
+
+func doFootnoteSearch(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a Footnote Search gRPC...")
+
+	req := &wordsearcher.SearchRequest{
+		Term:        "manuscripts",
+		Translation: "WEB",
+		Layer:       "footnotes",
+	}
+
+	res, err := c.Search(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	fmt.Printf("\nResponse from server: Number of Verses Found: %d;", len(res.GetVerses()))
+	for _, verse := range res.GetVerses() {
+		for _, footnote := range verse.GetFootnotes() {
+			fmt.Printf("\n%s %d:%d [%s] %s", verse.GetBookName(), verse.GetChapter(), verse.GetVerse(), footnote.GetType(), footnote.GetText())
+		}
+	}
+}
+
+func doImportPlan(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do an ImportPlan gRPC...")
+
+	req := &wordsearcher.ImportPlanRequest{
+		Name:   "TwoTrackSample",
+		Format: "csv",
+		Data:   []byte("day,reading1,reading2\n1,Gen 1,Matt 1\n2,Gen 2,Matt 2\n3,Gen 3,Matt 29\n"),
+		DryRun: true,
+	}
+
+	res, err := c.ImportPlan(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	for _, planErr := range res.GetErrors() {
+		fmt.Printf("\nDay %d reading %d %q: %s", planErr.GetDay(), planErr.GetTrack(), planErr.GetReading(), planErr.GetMessage())
+	}
+	fmt.Printf("\nSaved: %v", res.GetSaved())
+}
+
+func doExportPlan(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do an ExportPlan gRPC...")
+
+	req := &wordsearcher.ExportPlanRequest{
+		Name:   "McCheyneBasedYearly",
+		Format: "csv",
+	}
+
+	res, err := c.ExportPlan(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	fmt.Println(string(res.GetData()))
+}
+
+func doGeneratePlan(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a GeneratePlan gRPC...")
+
+	req := &wordsearcher.GeneratePlanRequest{
+		Books:        []int32{19, 20},
+		Days:         60,
+		Strategy:     "verses",
+		KeepChapters: true,
+		Name:         "PsalmsProverbs60",
+	}
+
+	res, err := c.GeneratePlan(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	for i, reading := range res.GetBiblePlan().GetDays() {
+		fmt.Printf("\nDay %d: %s", i+1, reading)
+	}
+}
+
+func doGenerateTrackPlan(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a multi-track GeneratePlan gRPC...")
+
+	// OT narrative, Psalms twice, the Gospels and the Epistles over a year
+	req := &wordsearcher.GeneratePlanRequest{
+		Days:     365,
+		Strategy: "verses",
+		Tracks: []*wordsearcher.PlanTrackScope{
+			{Books: []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}},
+			{Books: []int32{19}, Cycles: 2},
+			{Books: []int32{40, 41, 42, 43}, Cycles: 4},
+			{Books: []int32{45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65}, Cycles: 2},
+		},
+		Name: "FourTrackYearly",
+	}
+
+	res, err := c.GeneratePlan(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	for day := 0; day < 7; day++ {
+		fmt.Printf("\nDay %d:", day+1)
+		for _, track := range res.GetTracks() {
+			fmt.Printf(" %s;", track.GetDays()[day])
+		}
+	}
+}
+
+func doRegisterAndLogin(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do Register, Login and Me gRPCs...")
+
+	_, err := c.Register(context.Background(), &wordsearcher.RegisterRequest{
+		Username: "reader",
+		Email:    "reader@example.com",
+		Password: "correct horse battery",
+	})
+	if err != nil {
+		fmt.Printf("\nRegister failed (the user may exist already): %v", err)
+	}
+
+	res, err := c.Login(context.Background(), &wordsearcher.LoginRequest{
+		Username: "reader",
+		Password: "correct horse battery",
+	})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	// personal RPCs carry the token in the authorization metadata
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+res.GetToken())
+	me, err := c.Me(ctx, &wordsearcher.MeRequest{})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	fmt.Printf("\nSigned in as %s", me.GetUser().GetUsername())
+}
+
+// signedInContext logs in as the demo reader of doRegisterAndLogin and returns
+// a context carrying the token for the personal RPCs
+func signedInContext(c wordsearcher.WordsearcherServiceClient) context.Context {
+	res, err := c.Login(context.Background(), &wordsearcher.LoginRequest{
+		Username: "reader",
+		Password: "correct horse battery",
+	})
+	if err != nil {
+		log.Fatalf("Login failed: %v", err)
+	}
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+res.GetToken())
+}
+
+func doPlanProgress(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do Enroll, MarkComplete, Progress and CatchUp gRPCs...")
+	ctx := signedInContext(c)
+
+	_, err := c.Enroll(ctx, &wordsearcher.EnrollRequest{
+		Plan:      "McCheyneBasedYearly",
+		StartDate: time.Now().AddDate(0, 0, -5).Format("2006-01-02"),
+		Timezone:  "America/Chicago",
+		Restart:   true,
+	})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	// read the first two days, missing the next three
+	for day := int32(1); day <= 2; day++ {
+		if _, err := c.MarkComplete(ctx, &wordsearcher.MarkCompleteRequest{Plan: "McCheyneBasedYearly", Day: day}); err != nil {
+			log.Fatalf("Response failed: %v", err)
+		}
+	}
+
+	res, err := c.Progress(ctx, &wordsearcher.PlanProgressRequest{Plan: "McCheyneBasedYearly"})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	progress := res.GetProgress()
+	fmt.Printf("\nDay %d of %d, %.1f%% read, missed days %v", progress.GetCurrentDay(), progress.GetDays(), progress.GetPercent(), progress.GetMissedDays())
+
+	catchUp, err := c.CatchUp(ctx, &wordsearcher.CatchUpRequest{Plan: "McCheyneBasedYearly", Days: 3})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	for _, day := range catchUp.GetDays() {
+		fmt.Printf("\n%s:", day.GetDate())
+		for _, reading := range day.GetReadings() {
+			fmt.Printf(" %s (day %d);", reading.GetReading(), reading.GetDay())
+		}
+	}
+}
+
+func doAnnotations(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do CreateAnnotation, ListAnnotations and ChapterRange with annotations gRPCs...")
+	ctx := signedInContext(c)
+
+	highlight, err := c.CreateAnnotation(ctx, &wordsearcher.AnnotationRequest{
+		Annotation: &wordsearcher.Annotation{
+			Type:    "highlight",
+			Book:    43,
+			Chapter: 3,
+			Verse:   16,
+			Color:   "yellow",
+			Tags:    []string{"Gospel", "love"},
+		},
+	})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	fmt.Printf("\nHighlighted %s", highlight.GetAnnotation().GetReference())
+
+	_, err = c.CreateAnnotation(ctx, &wordsearcher.AnnotationRequest{
+		Annotation: &wordsearcher.Annotation{
+			Type:     "note",
+			Book:     43,
+			Chapter:  3,
+			Verse:    1,
+			EndVerse: 21,
+			Note:     "**Nicodemus** comes by night, compare *John 19:39*.",
+			Tags:     []string{"gospel"},
+		},
+	})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	list, err := c.ListAnnotations(ctx, &wordsearcher.ListAnnotationsRequest{Tag: "gospel"})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	for _, annotation := range list.GetAnnotations() {
+		fmt.Printf("\n%s %s %v", annotation.GetType(), annotation.GetReference(), annotation.GetTags())
+	}
+
+	res, err := c.ChapterRange(ctx, &wordsearcher.ChapterRangeRequest{
+		Book:               43,
+		Start:              3,
+		End:                3,
+		IncludeAnnotations: true,
+	})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	for _, verse := range res.GetVerses() {
+		fmt.Printf("\n%d:%d has %d annotations", verse.GetChapter(), verse.GetVerse(), len(verse.GetAnnotations()))
+	}
+
+	// clean up the demo annotations
+	for _, annotation := range list.GetAnnotations() {
+		if _, err := c.DeleteAnnotation(ctx, &wordsearcher.DeleteAnnotationRequest{Id: annotation.GetId()}); err != nil {
+			log.Fatalf("Response failed: %v", err)
+		}
+	}
+}
+
+func doVerseOfTheDay(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a VerseOfTheDay gRPC...")
+	res, err := c.VerseOfTheDay(context.Background(), &wordsearcher.VerseOfTheDayRequest{
+		List:     "encouragement",
+		Timezone: "America/Chicago",
+		History:  3,
+	})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	today := res.GetVerseOfTheDay()
+	fmt.Printf("\n%s: %s", today.GetDate(), today.GetReference())
+	for _, verse := range today.GetVerses() {
+		fmt.Printf("\n%d %s", verse.GetVerse(), verse.GetText())
+	}
+	for _, day := range res.GetHistory() {
+		fmt.Printf("\n%s: %s", day.GetDate(), day.GetReference())
+	}
+}
+
+func doMemorize(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do AddMemoryVerse, MemoryPrompt, ReviewMemoryVerse and MemoryProgress gRPCs...")
+	ctx := signedInContext(c)
+
+	added, err := c.AddMemoryVerse(ctx, &wordsearcher.AddMemoryVerseRequest{Reference: "Ps 119:11"})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	card := added.GetCard()
+
+	for _, mode := range []string{"cloze", "first_letter"} {
+		prompt, err := c.MemoryPrompt(ctx, &wordsearcher.MemoryPromptRequest{Id: card.GetId(), Mode: mode, Seed: 1})
+		if err != nil {
+			log.Fatalf("Response failed: %v", err)
+		}
+		fmt.Printf("\n%s %s: %s", card.GetReference(), mode, prompt.GetPrompt())
+	}
+
+	review, err := c.ReviewMemoryVerse(ctx, &wordsearcher.ReviewMemoryVerseRequest{
+		Id:     card.GetId(),
+		Answer: "Thy word have I hid in mine heart that I might not sin against thee",
+	})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	fmt.Printf("\nScore %.2f, quality %d, missed %v, next review in %d days", review.GetScore(), review.GetQuality(), review.GetMissedWords(), review.GetCard().GetInterval())
+
+	progress, err := c.MemoryProgress(ctx, &wordsearcher.MemoryProgressRequest{})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	fmt.Printf("\n%d cards, %d due, %d learning, %d memorized", progress.GetCards(), progress.GetDue(), progress.GetLearning(), progress.GetMature())
+
+	// clean up the demo card
+	if _, err := c.RemoveMemoryVerse(ctx, &wordsearcher.RemoveMemoryVerseRequest{Id: card.GetId()}); err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+}
+
+func doWordSearchPuzzle(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do a WordSearchPuzzle gRPC...")
+	res, err := c.WordSearchPuzzle(context.Background(), &wordsearcher.WordSearchPuzzleRequest{
+		Reference: "Ps 23",
+		Size:      14,
+		Backwards: true,
+		Seed:      2021,
+	})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	fmt.Printf("\n%s (seed %d)", res.GetReference(), res.GetSeed())
+	for _, row := range res.GetGrid() {
+		fmt.Printf("\n%s", strings.Join(strings.Split(row, ""), " "))
+	}
+	fmt.Printf("\nFind: %s", strings.Join(res.GetWords(), ", "))
+}
+
+func doQuiz(c wordsearcher.WordsearcherServiceClient) {
+	fmt.Println("\nStarting to do Quiz and CheckQuiz gRPCs...")
+	quiz := &wordsearcher.QuizRequest{
+		Testament: "new",
+		Questions: 5,
+	}
+	res, err := c.Quiz(context.Background(), quiz)
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+
+	// answer with the first choice of every question
+	var answers []string
+	for _, question := range res.GetQuestions() {
+		fmt.Printf("\n%d. %s\n   %s", question.GetNumber(), question.GetPrompt(), strings.Join(question.GetChoices(), " / "))
+		answers = append(answers, question.GetChoices()[0])
+	}
+
+	quiz.Seed = res.GetSeed()
+	check, err := c.CheckQuiz(context.Background(), &wordsearcher.CheckQuizRequest{Quiz: quiz, Answers: answers})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	fmt.Printf("\n%d of %d correct", check.GetCorrect(), check.GetTotal())
+}
+
+func doHealthCheck(cc *grpc.ClientConn) {
+	fmt.Println("\nStarting to do a Health Check gRPC...")
+	res, err := healthpb.NewHealthClient(cc).Check(context.Background(), &healthpb.HealthCheckRequest{
+		Service: "wordsearcher.WordsearcherService",
+	})
+	if err != nil {
+		log.Fatalf("Response failed: %v", err)
+	}
+	fmt.Printf("\nWordsearcherService is %v", res.GetStatus())
+}
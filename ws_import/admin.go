@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// importAdmin grants or revokes the admin flag of a registered user, which
+// the admin RPCs (plan import and export, saving a generated plan) check on
+// every call, so the change applies to tokens already issued.
+func importAdmin(args []string) error {
+	flags := flag.NewFlagSet("admin", flag.ExitOnError)
+	revoke := flags.Bool("revoke", false, "remove the admin flag instead of granting it")
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("admin needs one username")
+	}
+	username := strings.ToLower(flags.Arg(0))
+
+	if err := connect(); err != nil {
+		return err
+	}
+	res, err := db.Database(config.Database).Collection(config.Collections.User).UpdateOne(mongoCtx,
+		bson.M{"username": username},
+		bson.M{"$set": bson.M{"admin": !*revoke}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("there is no user %s", username)
+	}
+	fmt.Printf("%s admin: %v\n", username, !*revoke)
+	return nil
+}
@@ -0,0 +1,439 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// verse is a document in the verse collection
+type verse struct {
+	Translation string         `bson:"translation,omitempty"`
+	Book        int32          `bson:"book"`
+	BookName    string         `bson:"book_name"`
+	Chapter     int32          `bson:"chapter"`
+	Verse       int32          `bson:"verse"`
+	Text        string         `bson:"text"`
+	Keywords    string         `bson:"keywords"`
+	Words       []word         `bson:"words,omitempty"`
+	Spans       []wsbible.Span `bson:"spans,omitempty"`
+	TextFolded  string         `bson:"text_folded,omitempty"`
+	TextWOC     string         `bson:"text_woc,omitempty"`
+}
+
+// parsedBible is what a Bible parser hands back: the verses with their markup,
+// the section headings and footnotes found between them, and the books of
+// the file that are not in the canon (Apocrypha) with their verse counts
+type parsedBible struct {
+	verses    []*verse
+	pericopes []pericope
+	footnotes []footnote
+	unmapped  map[string]int
+}
+
+// bibleParsers are the supported Bible file formats
+var bibleParsers = map[string]func(io.Reader) (*parsedBible, error){
+	"osis":    parseOSISBible,
+	"usfm":    parseUSFMBible,
+	"zefania": parseZefaniaBible,
+}
+
+// importBible reads whole Bibles (or single books) in OSIS XML, USFM or Zefania
+// XML into the verse collection as a translation, with their markup spans,
+// Strong's tagging, section headings and footnotes. Verses are upserted on
+// their translation and reference so the import can be re-run, and -dry-run
+// reports the counts per book and the unmapped books without writing.
+func importBible(args []string) error {
+	flags := flag.NewFlagSet("bible", flag.ExitOnError)
+	format := flags.String("format", "", "osis, usfm or zefania")
+	code := flags.String("translation", "", "translation code stored on the verses, blank for the default text")
+	analyzer := flags.String("analyzer", "", "text analyzer for the search fields, defaults to the registered translation's")
+	dryRun := flags.Bool("dry-run", false, "parse the files and report without writing")
+	_ = flags.Parse(args)
+	if flags.NArg() == 0 {
+		return fmt.Errorf("bible needs at least one file")
+	}
+	parse, ok := bibleParsers[*format]
+	if !ok {
+		return fmt.Errorf("unknown bible format %q, use osis, usfm or zefania", *format)
+	}
+
+	bible := &parsedBible{unmapped: make(map[string]int)}
+	for _, name := range flags.Args() {
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		fileBible, err := parse(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("error parsing %s: %v", name, err)
+		}
+		bible.verses = append(bible.verses, fileBible.verses...)
+		bible.pericopes = append(bible.pericopes, fileBible.pericopes...)
+		bible.footnotes = append(bible.footnotes, fileBible.footnotes...)
+		for book, count := range fileBible.unmapped {
+			bible.unmapped[book] += count
+		}
+	}
+	for i := range bible.pericopes {
+		bible.pericopes[i].Translation = *code
+	}
+	for i := range bible.footnotes {
+		bible.footnotes[i].Translation = *code
+	}
+	bible.report()
+	if *dryRun {
+		return nil
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
+	if *analyzer == "" {
+		*analyzer = wsbible.AnalyzerStandard
+		var registered translation
+		err := db.Database(config.Database).Collection(config.Collections.Translation).
+			FindOne(mongoCtx, bson.M{"code": *code}).Decode(&registered)
+		if err == nil {
+			*analyzer = registered.Analyzer
+		}
+	}
+
+	// upsert each verse keyed on its translation and reference
+	var models []mongo.WriteModel
+	for _, v := range bible.verses {
+		v.Translation = *code
+		if wsbible.Folds(*analyzer) {
+			v.TextFolded = wsbible.Fold(*analyzer, v.Text)
+		}
+		if woc := wsbible.SpanText(v.Text, v.Spans, wsbible.SpanWordsOfChrist); woc != "" {
+			if wsbible.Folds(*analyzer) {
+				woc = wsbible.Fold(*analyzer, woc)
+			}
+			v.TextWOC = woc
+		}
+		filter := bson.M{
+			"translation": *code,
+			"book":        v.Book,
+			"chapter":     v.Chapter,
+			"verse":       v.Verse,
+		}
+		if *code == "" {
+			filter["translation"] = bson.M{"$in": bson.A{"", nil}}
+		}
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(filter).
+			SetReplacement(v).
+			SetUpsert(true))
+	}
+
+	collection := db.Database(config.Database).Collection(config.Collections.Verse)
+	_, err := collection.Indexes().CreateOne(mongoCtx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "translation", Value: 1},
+			{Key: "book", Value: 1},
+			{Key: "chapter", Value: 1},
+			{Key: "verse", Value: 1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating the verse index: %v", err)
+	}
+	if err := bulkWrite(collection, models); err != nil {
+		return err
+	}
+	if err := replacePericopes(*code, bible.pericopes); err != nil {
+		return err
+	}
+	return replaceFootnotes(*code, bible.footnotes)
+}
+
+// report prints the chapter and verse counts per book and the unmapped books
+func (b *parsedBible) report() {
+	type bookCount struct {
+		chapters map[int32]bool
+		verses   int
+	}
+	counts := make(map[int32]*bookCount)
+	for _, v := range b.verses {
+		count, ok := counts[v.Book]
+		if !ok {
+			count = &bookCount{chapters: make(map[int32]bool)}
+			counts[v.Book] = count
+		}
+		count.chapters[v.Chapter] = true
+		count.verses++
+	}
+
+	fmt.Printf("%-18s %8s %8s\n", "Book", "Chapters", "Verses")
+	for _, book := range wsbible.Books {
+		if count, ok := counts[book.Number]; ok {
+			missing := ""
+			if int32(len(count.chapters)) != book.Chapters {
+				missing = fmt.Sprintf(" (expected %d chapters)", book.Chapters)
+			}
+			fmt.Printf("%-18s %8d %8d%s\n", book.Name, len(count.chapters), count.verses, missing)
+		}
+	}
+	fmt.Printf("Total: %d books, %d verses, %d section headings, %d footnotes\n",
+		len(counts), len(b.verses), len(b.pericopes), len(b.footnotes))
+
+	if len(b.unmapped) > 0 {
+		var names []string
+		for name := range b.unmapped {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Println("Unmapped books (not imported):")
+		for _, name := range names {
+			fmt.Printf("  %s: %d verses\n", name, b.unmapped[name])
+		}
+	}
+}
+
+// verseBuilder accumulates the text of a verse while a parser walks its
+// markup, tracking the code point offsets of spans, Strong's tagged words and
+// footnote anchors. Runs of white space are collapsed to a single space.
+type verseBuilder struct {
+	book, chapter, verse int32
+	text                 []rune
+	spans                []wsbible.Span
+	tags                 []strongsRange
+	footnotes            []footnote
+}
+
+// strongsRange is a run of verse text tagged with Strong's numbers
+type strongsRange struct {
+	start, end int32
+	strongs    []string
+}
+
+func newVerseBuilder(book, chapter, number int32) *verseBuilder {
+	return &verseBuilder{book: book, chapter: chapter, verse: number}
+}
+
+// offset is the current length of the text in code points
+func (b *verseBuilder) offset() int32 {
+	return int32(len(b.text))
+}
+
+// write appends text, collapsing white space
+func (b *verseBuilder) write(s string) {
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			if len(b.text) == 0 || b.text[len(b.text)-1] == ' ' {
+				continue
+			}
+			r = ' '
+		}
+		b.text = append(b.text, r)
+	}
+}
+
+// span records a markup span from start to the current offset
+func (b *verseBuilder) span(spanType string, start int32) {
+	if end := b.offset(); end > start {
+		b.spans = append(b.spans, wsbible.Span{Type: spanType, Start: start, End: end})
+	}
+}
+
+// tag records Strong's numbers for the text from start to the current offset
+func (b *verseBuilder) tag(start int32, numbers []string) {
+	var strongs []string
+	for _, number := range numbers {
+		if normalized, err := wsbible.NormalizeStrongs(number); err == nil {
+			strongs = append(strongs, normalized)
+		}
+	}
+	if end := b.offset(); end > start && len(strongs) > 0 {
+		b.tags = append(b.tags, strongsRange{start: start, end: end, strongs: strongs})
+	}
+}
+
+// note anchors a footnote at the current offset
+func (b *verseBuilder) note(noteType, text string) {
+	text = strings.Join(strings.Fields(text), " ")
+	if text == "" {
+		return
+	}
+	b.footnotes = append(b.footnotes, footnote{
+		Book:    b.book,
+		Chapter: b.chapter,
+		Verse:   b.verse,
+		Offset:  b.offset(),
+		Type:    noteType,
+		Text:    text,
+	})
+}
+
+// finish trims the text and builds the verse document, splitting the text
+// into words when any of it carries Strong's tags
+func (b *verseBuilder) finish() (*verse, []footnote) {
+	for len(b.text) > 0 && b.text[len(b.text)-1] == ' ' {
+		b.text = b.text[:len(b.text)-1]
+	}
+	length := b.offset()
+	for i := range b.spans {
+		if b.spans[i].End > length {
+			b.spans[i].End = length
+		}
+	}
+	for i := range b.footnotes {
+		if b.footnotes[i].Offset > length {
+			b.footnotes[i].Offset = length
+		}
+	}
+
+	book, _ := wsbible.BookByNumber(b.book)
+	v := &verse{
+		Book:     b.book,
+		BookName: book.Name,
+		Chapter:  b.chapter,
+		Verse:    b.verse,
+		Text:     string(b.text),
+		Spans:    b.spans,
+	}
+	if len(b.tags) > 0 {
+		v.Words = b.words()
+	}
+	return v, b.footnotes
+}
+
+// words splits the text into words, each carrying the Strong's numbers of the
+// tagged runs it overlaps
+func (b *verseBuilder) words() []word {
+	var words []word
+	start := -1
+	for i := 0; i <= len(b.text); i++ {
+		inWord := i < len(b.text) && (unicode.IsLetter(b.text[i]) || unicode.IsNumber(b.text[i]) ||
+			unicode.Is(unicode.Mn, b.text[i]) || b.text[i] == '\'' || b.text[i] == '’')
+		if inWord && start < 0 {
+			start = i
+		}
+		if !inWord && start >= 0 {
+			w := word{Text: strings.Trim(string(b.text[start:i]), "'’")}
+			for _, tag := range b.tags {
+				if tag.start < int32(i) && tag.end > int32(start) {
+					w.Strongs = append(w.Strongs, tag.strongs...)
+				}
+			}
+			words = append(words, w)
+			start = -1
+		}
+	}
+	return words
+}
+
+// bibleCollector is driven by the format parsers as they walk a Bible,
+// building a verse at a time. Markup spans can cross verse boundaries (OSIS
+// milestones, a USFM \wj running over several verses) so open spans are
+// closed at the end of each verse and reopened at the start of the next.
+type bibleCollector struct {
+	bible    *parsedBible
+	current  *verseBuilder
+	open     []openSpan
+	headings []string
+}
+
+// openSpan is a markup span that has started but not ended, id is set for
+// milestone spans and blank for container elements
+type openSpan struct {
+	id, spanType string
+	start        int32
+}
+
+func newBibleCollector() *bibleCollector {
+	return &bibleCollector{bible: &parsedBible{unmapped: make(map[string]int)}}
+}
+
+// startVerse ends the current verse and starts a new one, book 0 is a book
+// outside the canon whose text is counted under name and dropped
+func (c *bibleCollector) startVerse(name string, book, chapter, number int32) {
+	c.endVerse()
+	if book == 0 {
+		c.bible.unmapped[name]++
+		c.headings = nil
+		return
+	}
+	c.current = newVerseBuilder(book, chapter, number)
+	for i := range c.open {
+		c.open[i].start = 0
+	}
+	for _, title := range c.headings {
+		c.bible.pericopes = append(c.bible.pericopes, pericope{
+			Book:    book,
+			Chapter: chapter,
+			Verse:   number,
+			Title:   title,
+		})
+	}
+	c.headings = nil
+}
+
+// endVerse closes the spans still open and adds the current verse
+func (c *bibleCollector) endVerse() {
+	if c.current == nil {
+		return
+	}
+	for _, open := range c.open {
+		c.current.span(open.spanType, open.start)
+	}
+	v, footnotes := c.current.finish()
+	c.bible.verses = append(c.bible.verses, v)
+	c.bible.footnotes = append(c.bible.footnotes, footnotes...)
+	c.current = nil
+}
+
+// write adds text to the current verse, text between verses is dropped
+func (c *bibleCollector) write(text string) {
+	if c.current != nil {
+		c.current.write(text)
+	}
+}
+
+// openSpan starts a markup span at the current offset
+func (c *bibleCollector) openSpan(id, spanType string) {
+	var start int32
+	if c.current != nil {
+		start = c.current.offset()
+	}
+	c.open = append(c.open, openSpan{id: id, spanType: spanType, start: start})
+}
+
+// closeSpan ends the latest open span with the id, or for container elements
+// (blank id) the latest one of the type
+func (c *bibleCollector) closeSpan(id, spanType string) {
+	for i := len(c.open) - 1; i >= 0; i-- {
+		open := c.open[i]
+		if open.id != id || (id == "" && open.spanType != spanType) {
+			continue
+		}
+		if c.current != nil {
+			c.current.span(open.spanType, open.start)
+		}
+		c.open = append(c.open[:i], c.open[i+1:]...)
+		return
+	}
+}
+
+// heading queues a section heading for the next verse
+func (c *bibleCollector) heading(title string) {
+	if title = strings.Join(strings.Fields(title), " "); title != "" {
+		c.headings = append(c.headings, title)
+	}
+}
+
+// finish ends the last verse and returns the parsed Bible
+func (c *bibleCollector) finish() *parsedBible {
+	c.endVerse()
+	return c.bible
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+)
+
+// checkBible compares a parsed Bible with the one expected, verse by verse
+func checkBible(t *testing.T, got, want *parsedBible) {
+	t.Helper()
+	if len(got.verses) != len(want.verses) {
+		for _, v := range got.verses {
+			t.Logf("%d %d:%d %q", v.Book, v.Chapter, v.Verse, v.Text)
+		}
+		t.Fatalf("got %d verses, want %d", len(got.verses), len(want.verses))
+	}
+	for i := range want.verses {
+		if !reflect.DeepEqual(got.verses[i], want.verses[i]) {
+			t.Errorf("verse %d\n got %+v\nwant %+v", i, got.verses[i], want.verses[i])
+		}
+	}
+	if !reflect.DeepEqual(got.footnotes, want.footnotes) {
+		t.Errorf("footnotes\n got %+v\nwant %+v", got.footnotes, want.footnotes)
+	}
+	if !reflect.DeepEqual(got.pericopes, want.pericopes) {
+		t.Errorf("headings\n got %+v\nwant %+v", got.pericopes, want.pericopes)
+	}
+	if !reflect.DeepEqual(got.unmapped, want.unmapped) {
+		t.Errorf("unmapped books %v, want %v", got.unmapped, want.unmapped)
+	}
+}
+
+func TestParseOSISBible(t *testing.T) {
+	input := `<osis><osisText>
+<header><work osisWork="KJV"><title>King James Version</title></work></header>
+<div type="book" osisID="John"><title type="main">John</title>
+<chapter osisID="John.11">
+<title>Jesus Weeps</title>
+<verse sID="John.11.35" osisID="John.11.35"/><w lemma="strong:G2424">Jesus</w> <w lemma="strong:G01145">wept</w>.<note type="x-literal">Greek: shed tears</note><verse eID="John.11.35"/>
+</chapter>
+<chapter osisID="John.14">
+<verse sID="John.14.1" osisID="John.14.1"/><q who="Jesus" sID="q1"/>Let not your heart be troubled:<note type="crossReference">John 14:27</note><verse eID="John.14.1"/>
+<verse sID="John.14.2" osisID="John.14.2"/>In my Father's house are many mansions.<q eID="q1"/> And <transChange type="added">it is</transChange> so.<verse eID="John.14.2"/>
+</chapter></div>
+<div type="book" osisID="Tob"><chapter osisID="Tob.1">
+<verse osisID="Tob.1.1">The book of the words of Tobit.</verse>
+<verse osisID="Tob.1.2">Who in the time of Enemessar.</verse>
+</chapter></div>
+<div type="book" osisID="Gen"><chapter osisID="Gen.2">
+<verse osisID="Gen.2.4">the <divineName>LORD</divineName> God made the earth<note type="variant">Or, JEHOVAH</note></verse>
+</chapter></div>
+</osisText></osis>`
+	want := &parsedBible{
+		verses: []*verse{
+			{Book: 43, BookName: "John", Chapter: 11, Verse: 35, Text: "Jesus wept.",
+				Words: []word{{Text: "Jesus", Strongs: []string{"G2424"}}, {Text: "wept", Strongs: []string{"G1145"}}}},
+			// the words of Christ milestone runs over the verse end
+			{Book: 43, BookName: "John", Chapter: 14, Verse: 1, Text: "Let not your heart be troubled:",
+				Spans: []wsbible.Span{{Type: wsbible.SpanWordsOfChrist, Start: 0, End: 31}}},
+			{Book: 43, BookName: "John", Chapter: 14, Verse: 2, Text: "In my Father's house are many mansions. And it is so.",
+				Spans: []wsbible.Span{{Type: wsbible.SpanWordsOfChrist, Start: 0, End: 39}, {Type: wsbible.SpanAdded, Start: 44, End: 49}}},
+			{Book: 1, BookName: "Genesis", Chapter: 2, Verse: 4, Text: "the LORD God made the earth",
+				Spans: []wsbible.Span{{Type: wsbible.SpanDivineName, Start: 4, End: 8}}},
+		},
+		footnotes: []footnote{
+			{Book: 43, Chapter: 11, Verse: 35, Offset: 11, Type: "literal", Text: "Greek: shed tears"},
+			{Book: 1, Chapter: 2, Verse: 4, Offset: 27, Type: "alternate", Text: "Or, JEHOVAH"},
+		},
+		pericopes: []pericope{{Book: 43, Chapter: 11, Verse: 35, Title: "Jesus Weeps"}},
+		unmapped:  map[string]int{"Tob": 2},
+	}
+	got, err := parseOSISBible(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkBible(t, got, want)
+}
+
+func TestParseUSFMBible(t *testing.T) {
+	input := `\id MAT World English Bible
+\h Matthew
+\mt1 Matthew
+\c 5
+\s1 The Beatitudes
+\p
+\v 3 \wj “Blessed are the poor in spirit,\f + \fr 5:3 \ft or, humble\f* for theirs is the Kingdom of Heaven.\wj*
+\c 6
+\p
+\v 9 \wj Pray like this:
+\q1 ‘Our Father in heaven,
+\v 10 may your Kingdom come.\wj*
+\v 11 \w Give|strong="G1325"\w* us \add today\add*\x + \xo 6:11 \xt Prov 30:8\x* \w bread|strong="G0740"\w*.\f + \fr 6:11 \fqa daily \ft bread\f*
+\id TOB
+\c 1
+\p
+\v 1 The book of the words of Tobit.
+`
+	want := &parsedBible{
+		verses: []*verse{
+			{Book: 40, BookName: "Matthew", Chapter: 5, Verse: 3, Text: "“Blessed are the poor in spirit, for theirs is the Kingdom of Heaven.",
+				Spans: []wsbible.Span{{Type: wsbible.SpanWordsOfChrist, Start: 0, End: 69}}},
+			// \wj runs over the verse end and is reopened in the next verse
+			{Book: 40, BookName: "Matthew", Chapter: 6, Verse: 9, Text: "Pray like this: ‘Our Father in heaven,",
+				Spans: []wsbible.Span{{Type: wsbible.SpanWordsOfChrist, Start: 0, End: 38}}},
+			{Book: 40, BookName: "Matthew", Chapter: 6, Verse: 10, Text: "may your Kingdom come.",
+				Spans: []wsbible.Span{{Type: wsbible.SpanWordsOfChrist, Start: 0, End: 22}}},
+			{Book: 40, BookName: "Matthew", Chapter: 6, Verse: 11, Text: "Give us today bread.",
+				Spans: []wsbible.Span{{Type: wsbible.SpanAdded, Start: 8, End: 13}},
+				Words: []word{{Text: "Give", Strongs: []string{"G1325"}}, {Text: "us"}, {Text: "today"}, {Text: "bread", Strongs: []string{"G740"}}}},
+		},
+		footnotes: []footnote{
+			{Book: 40, Chapter: 5, Verse: 3, Offset: 32, Type: "note", Text: "or, humble"},
+			{Book: 40, Chapter: 6, Verse: 11, Offset: 20, Type: "alternate", Text: "daily bread"},
+		},
+		pericopes: []pericope{{Book: 40, Chapter: 5, Verse: 3, Title: "The Beatitudes"}},
+		unmapped:  map[string]int{"TOB": 1},
+	}
+	got, err := parseUSFMBible(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkBible(t, got, want)
+}
+
+func TestParseZefaniaBible(t *testing.T) {
+	input := `<XMLBIBLE>
+<INFORMATION><title>King James Version</title></INFORMATION>
+<BIBLEBOOK bnumber="1" bname="Genesis"><CHAPTER cnumber="1">
+<CAPTION>The Creation</CAPTION>
+<VERS vnumber="1"><gr str="7225">In the beginning</gr> <gr str="430">God</gr> created the heaven and the earth.</VERS>
+</CHAPTER><CHAPTER cnumber="2">
+<VERS vnumber="4">the <STYLE fs="divineName">LORD</STYLE> God made<NOTE>Or, Jehovah</NOTE> the earth<BR/>and the heavens</VERS>
+</CHAPTER></BIBLEBOOK>
+<BIBLEBOOK bnumber="43" bname="John"><CHAPTER cnumber="11">
+<VERS vnumber="35"><STYLE css="color:#FF0000">Jesus wept.</STYLE></VERS>
+<VERS vnumber="36">Then said the Jews, Behold how he loved <STYLE fs="italic">him</STYLE>!</VERS>
+</CHAPTER></BIBLEBOOK>
+<BIBLEBOOK bnumber="70" bname="Tobit"><CHAPTER cnumber="1">
+<VERS vnumber="1">The book of the words of Tobit.</VERS>
+</CHAPTER></BIBLEBOOK>
+</XMLBIBLE>`
+	want := &parsedBible{
+		verses: []*verse{
+			{Book: 1, BookName: "Genesis", Chapter: 1, Verse: 1, Text: "In the beginning God created the heaven and the earth.",
+				Words: []word{
+					{Text: "In", Strongs: []string{"H7225"}}, {Text: "the", Strongs: []string{"H7225"}}, {Text: "beginning", Strongs: []string{"H7225"}},
+					{Text: "God", Strongs: []string{"H430"}}, {Text: "created"}, {Text: "the"}, {Text: "heaven"}, {Text: "and"}, {Text: "the"}, {Text: "earth"},
+				}},
+			{Book: 1, BookName: "Genesis", Chapter: 2, Verse: 4, Text: "the LORD God made the earth and the heavens",
+				Spans: []wsbible.Span{{Type: wsbible.SpanDivineName, Start: 4, End: 8}}},
+			{Book: 43, BookName: "John", Chapter: 11, Verse: 35, Text: "Jesus wept.",
+				Spans: []wsbible.Span{{Type: wsbible.SpanWordsOfChrist, Start: 0, End: 11}}},
+			{Book: 43, BookName: "John", Chapter: 11, Verse: 36, Text: "Then said the Jews, Behold how he loved him!",
+				Spans: []wsbible.Span{{Type: wsbible.SpanAdded, Start: 40, End: 43}}},
+		},
+		footnotes: []footnote{{Book: 1, Chapter: 2, Verse: 4, Offset: 17, Type: "note", Text: "Or, Jehovah"}},
+		pericopes: []pericope{{Book: 1, Chapter: 1, Verse: 1, Title: "The Creation"}},
+		unmapped:  map[string]int{"Tobit": 1},
+	}
+	got, err := parseZefaniaBible(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkBible(t, got, want)
+}
+
+func TestImportBibleUnknownFormat(t *testing.T) {
+	err := importBible([]string{"-format", "sword", "kjv.zip"})
+	if err == nil || !strings.Contains(err.Error(), "unknown bible format") {
+		t.Errorf("got error %v, want an unknown bible format error", err)
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// crossReference is a document in the crossreference collection
+type crossReference struct {
+	FromBook     int32 `bson:"from_book"`
+	FromChapter  int32 `bson:"from_chapter"`
+	FromVerse    int32 `bson:"from_verse"`
+	ToBook       int32 `bson:"to_book"`
+	ToChapter    int32 `bson:"to_chapter"`
+	ToVerse      int32 `bson:"to_verse"`
+	ToEndBook    int32 `bson:"to_end_book"`
+	ToEndChapter int32 `bson:"to_end_chapter"`
+	ToEndVerse   int32 `bson:"to_end_verse"`
+	Votes        int32 `bson:"votes"`
+}
+
+// importCrossReferences reads the tab separated cross reference file published
+// by OpenBible.info (derived from the Treasury of Scripture Knowledge):
+//
+//	From Verse	To Verse	Votes
+//	Gen.1.1	Prov.8.22-Prov.8.30	59
+//
+// Links are upserted on their from and to verses so the import can be re-run.
+func importCrossReferences(args []string) error {
+	flags := flag.NewFlagSet("crossrefs", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "parse the file and report without writing")
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("crossrefs needs exactly one file")
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var refs []crossReference
+	skipped := 0
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "From Verse") {
+			continue
+		}
+
+		ref, err := parseCrossReference(line)
+		if err != nil {
+			fmt.Printf("Line %d skipped: %v\n", lineNumber, err)
+			skipped++
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	fmt.Printf("Parsed %d cross references, skipped %d lines\n", len(refs), skipped)
+	if *dryRun {
+		return nil
+	}
+
+	// upsert each link keyed on its from and to verses
+	var models []mongo.WriteModel
+	for _, ref := range refs {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{
+				"from_book":      ref.FromBook,
+				"from_chapter":   ref.FromChapter,
+				"from_verse":     ref.FromVerse,
+				"to_book":        ref.ToBook,
+				"to_chapter":     ref.ToChapter,
+				"to_verse":       ref.ToVerse,
+				"to_end_book":    ref.ToEndBook,
+				"to_end_chapter": ref.ToEndChapter,
+				"to_end_verse":   ref.ToEndVerse,
+			}).
+			SetReplacement(ref).
+			SetUpsert(true))
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
+	collection := db.Database(config.Database).Collection(config.Collections.CrossReference)
+	_, err = collection.Indexes().CreateOne(mongoCtx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "from_book", Value: 1},
+			{Key: "from_chapter", Value: 1},
+			{Key: "from_verse", Value: 1},
+			{Key: "votes", Value: -1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating the cross reference index: %v", err)
+	}
+	return bulkWrite(collection, models)
+}
+
+// parseCrossReference parses one "from <tab> to <tab> votes" line
+func parseCrossReference(line string) (crossReference, error) {
+	var ref crossReference
+	fields := strings.Split(line, "\t")
+	if len(fields) < 3 {
+		return ref, fmt.Errorf("expected 3 tab separated fields, found %d", len(fields))
+	}
+
+	from, err := wsbible.ParseOSIS(fields[0])
+	if err != nil {
+		return ref, err
+	}
+	if from.Verse == 0 {
+		return ref, fmt.Errorf("cross reference from %q is not a verse", fields[0])
+	}
+	to, err := wsbible.ParseOSIS(fields[1])
+	if err != nil {
+		return ref, err
+	}
+	votes, err := strconv.Atoi(strings.TrimSpace(fields[2]))
+	if err != nil {
+		return ref, fmt.Errorf("invalid votes %q", fields[2])
+	}
+
+	return crossReference{
+		FromBook:     from.Book,
+		FromChapter:  from.Chapter,
+		FromVerse:    from.Verse,
+		ToBook:       to.Book,
+		ToChapter:    to.Chapter,
+		ToVerse:      to.Verse,
+		ToEndBook:    to.EndBook,
+		ToEndChapter: to.EndChapter,
+		ToEndVerse:   to.EndVerse,
+		Votes:        int32(votes),
+	}, nil
+}
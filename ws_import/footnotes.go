@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// footnote is a document in the footnote collection
+type footnote struct {
+	Translation string `bson:"translation"`
+	Book        int32  `bson:"book"`
+	Chapter     int32  `bson:"chapter"`
+	Verse       int32  `bson:"verse"`
+	Offset      int32  `bson:"offset"`
+	Type        string `bson:"type"`
+	Text        string `bson:"text"`
+}
+
+// footnoteTypes are the kinds of note a footnote can be
+var footnoteTypes = map[string]bool{"note": true, "alternate": true, "literal": true}
+
+// importFootnotes reads the footnotes of a translation, one per line with the
+// OSIS verse id, the code point offset into the verse text the note is
+// anchored at, its type (note, alternate or literal) and text:
+//
+//	Gen.1.2	14	alternate	Or wind
+//
+// The footnotes already stored for the translation in the books of the file
+// are replaced.
+func importFootnotes(args []string) error {
+	flags := flag.NewFlagSet("footnotes", flag.ExitOnError)
+	translation := flags.String("translation", "", "translation code the notes belong to, blank for the default text")
+	dryRun := flags.Bool("dry-run", false, "parse the file and report without writing")
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("footnotes needs exactly one file")
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var footnotes []footnote
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.SplitN(line, "\t", 4)
+		if len(fields) != 4 {
+			return fmt.Errorf("line %d: expected a verse, offset, type and text", lineNumber)
+		}
+		ref, err := wsbible.ParseOSIS(fields[0])
+		if err != nil || ref.Verse == 0 {
+			return fmt.Errorf("line %d: invalid verse %q", lineNumber, fields[0])
+		}
+		offset, err := strconv.Atoi(fields[1])
+		if err != nil || offset < 0 {
+			return fmt.Errorf("line %d: invalid offset %q", lineNumber, fields[1])
+		}
+		if !footnoteTypes[fields[2]] {
+			return fmt.Errorf("line %d: unknown footnote type %q", lineNumber, fields[2])
+		}
+		footnotes = append(footnotes, footnote{
+			Translation: *translation,
+			Book:        ref.Book,
+			Chapter:     ref.Chapter,
+			Verse:       ref.Verse,
+			Offset:      int32(offset),
+			Type:        fields[2],
+			Text:        strings.TrimSpace(fields[3]),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	fmt.Printf("Parsed %d footnotes\n", len(footnotes))
+	if *dryRun {
+		return nil
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
+	return replaceFootnotes(*translation, footnotes)
+}
+
+// replaceFootnotes replaces the footnotes of the translation in the books the
+// footnotes cover
+func replaceFootnotes(translation string, footnotes []footnote) error {
+	collection := db.Database(config.Database).Collection(config.Collections.Footnote)
+
+	var books bson.A
+	seen := make(map[int32]bool)
+	var models []mongo.WriteModel
+	for _, f := range footnotes {
+		if !seen[f.Book] {
+			seen[f.Book] = true
+			books = append(books, f.Book)
+		}
+		models = append(models, mongo.NewInsertOneModel().SetDocument(f))
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	filter := bson.M{"translation": translation, "book": bson.M{"$in": books}}
+	if translation == "" {
+		filter["translation"] = bson.M{"$in": bson.A{"", nil}}
+	}
+	deleted, err := collection.DeleteMany(mongoCtx, filter)
+	if err != nil {
+		return fmt.Errorf("error removing the old footnotes: %v", err)
+	}
+	fmt.Printf("Removed %d old footnotes\n", deleted.DeletedCount)
+
+	_, err = collection.Indexes().CreateOne(mongoCtx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "translation", Value: 1},
+			{Key: "book", Value: 1},
+			{Key: "chapter", Value: 1},
+			{Key: "verse", Value: 1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating the footnote index: %v", err)
+	}
+	return bulkWrite(collection, models)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/jwjones2/wordsearcher-server/wsconfig"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// define global mongo variables
+var db *mongo.Client
+var mongoCtx context.Context
+
+// config names the database and collections to import into, from the config
+// file and environment the server uses, WS_CONFIG naming the file
+var config *wsconfig.Config
+
+// batchSize is the number of writes sent to MongoDB in one bulk write
+const batchSize = 1000
+
+// command is one of the ws_import sub commands, run with the remaining arguments
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var commands = map[string]command{
+	"admin":       {"admin [-revoke] <username>\tgrant or revoke a user's admin access", importAdmin},
+	"bible":       {"bible -format osis|usfm|zefania [-translation <code>] [-analyzer] [-dry-run] <files>\timport a whole Bible with its markup, headings and footnotes", importBible},
+	"crossrefs":   {"crossrefs [-dry-run] <file>\timport an OpenBible.info / TSK cross reference file", importCrossReferences},
+	"footnotes":   {"footnotes [-translation <code>] [-dry-run] <file>\timport footnotes and translator notes", importFootnotes},
+	"interlinear": {"interlinear -format morphgnt|oshb [-dry-run] <files>\timport Greek or Hebrew morphology for the Interlinear RPC", importInterlinear},
+	"lexicon":     {"lexicon [-dry-run] <file>\timport an Open Scriptures Strong's Hebrew or Greek dictionary", importLexicon},
+	"pericopes":   {"pericopes [-translation <code>] [-dry-run] <file>\timport section headings", importPericopes},
+	"plans":       {"plans [-format csv|json] [-name <plan>] [-export] [-dry-run] <file>\timport or export reading plans", importPlans},
+	"strongs":     {"strongs [-translation <code>] [-dry-run] <file>\ttag verses with the Strong's numbers of a KJV+ text", importStrongs},
+	"translation": {"translation -code <code> [-name] [-language] [-analyzer standard|greek|hebrew]\tregister a translation and reindex its verses for search", importTranslation},
+	"verses":      {"verses -name <list> [-dry-run] <file>\timport a verse list for the verse of the day", importVerses},
+}
+
+func usage() {
+	fmt.Println("Usage: ws_import <command> [options]")
+	fmt.Println("Commands:")
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Println("  " + commands[name].usage)
+	}
+	os.Exit(2)
+}
+
+func main() {
+	// set the logging to be able to catch file name and line number in the log
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+
+	if len(os.Args) < 2 {
+		usage()
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+	}
+
+	var err error
+	config, err = wsconfig.Load("ws_import", nil)
+	if err != nil {
+		log.Fatalf("Error loading the configuration: %v", err)
+	}
+
+	mongoCtx = context.Background()
+	err = cmd.run(os.Args[2:])
+	if db != nil {
+		if disconnectErr := db.Disconnect(mongoCtx); disconnectErr != nil {
+			log.Printf("Error disconnecting from MongoDB: %v", disconnectErr)
+		}
+	}
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+	fmt.Println("Done!")
+}
+
+// connect connects to Mongodb and initializes the global variables, it is
+// only called once a command has parsed its input and is not a dry run
+func connect() error {
+	fmt.Println("Connecting to MongoDB...")
+	var err error
+	db, err = mongo.Connect(mongoCtx, options.Client().
+		ApplyURI(config.MongoURI).
+		SetConnectTimeout(config.ConnectTimeout).
+		SetServerSelectionTimeout(config.ConnectTimeout))
+	if err != nil {
+		return fmt.Errorf("error connecting to MongoDB client: %v", err)
+	}
+	return nil
+}
+
+// bulkWrite sends the write models to the collection in batches of batchSize
+func bulkWrite(collection *mongo.Collection, models []mongo.WriteModel) error {
+	for start := 0; start < len(models); start += batchSize {
+		end := start + batchSize
+		if end > len(models) {
+			end = len(models)
+		}
+		_, err := collection.BulkWrite(mongoCtx, models[start:end], options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Wrote %d of %d documents\n", end, len(models))
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bufio"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// interlinearVerse is a document in the interlinear collection
+type interlinearVerse struct {
+	Book     int32              `bson:"book"`
+	Chapter  int32              `bson:"chapter"`
+	Verse    int32              `bson:"verse"`
+	Source   string             `bson:"source"`
+	Language string             `bson:"language"`
+	Tokens   []interlinearToken `bson:"tokens"`
+}
+
+// interlinearToken is one source language word of an interlinear verse
+type interlinearToken struct {
+	Position int32  `bson:"position"`
+	Text     string `bson:"text"`
+	Lemma    string `bson:"lemma"`
+	Strongs  string `bson:"strongs,omitempty"`
+	Morph    string `bson:"morph"`
+	Gloss    string `bson:"gloss,omitempty"`
+}
+
+// importInterlinear reads source language morphology files into the
+// interlinear collection, one document per verse and source. Supported formats:
+//
+//	morphgnt	MorphGNT SBLGNT text files (61-Mt-morphgnt.txt ...)
+//	oshb		Open Scriptures Hebrew Bible OSIS files (Gen.xml ...)
+func importInterlinear(args []string) error {
+	flags := flag.NewFlagSet("interlinear", flag.ExitOnError)
+	format := flags.String("format", "", "morphgnt or oshb")
+	dryRun := flags.Bool("dry-run", false, "parse the files and report without writing")
+	_ = flags.Parse(args)
+	if flags.NArg() == 0 {
+		return fmt.Errorf("interlinear needs at least one file")
+	}
+
+	var parse func(io.Reader) ([]*interlinearVerse, error)
+	switch *format {
+	case "morphgnt":
+		parse = parseMorphGNT
+	case "oshb":
+		parse = parseOSHB
+	default:
+		return fmt.Errorf("unknown interlinear format %q, use morphgnt or oshb", *format)
+	}
+
+	var verses []*interlinearVerse
+	for _, name := range flags.Args() {
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		fileVerses, err := parse(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("error parsing %s: %v", name, err)
+		}
+		fmt.Printf("%s: %d verses\n", name, len(fileVerses))
+		verses = append(verses, fileVerses...)
+	}
+	if *dryRun {
+		return nil
+	}
+
+	// upsert each verse keyed on its reference and source
+	var models []mongo.WriteModel
+	for _, verse := range verses {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{
+				"book":    verse.Book,
+				"chapter": verse.Chapter,
+				"verse":   verse.Verse,
+				"source":  verse.Source,
+			}).
+			SetReplacement(verse).
+			SetUpsert(true))
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
+	collection := db.Database(config.Database).Collection(config.Collections.Interlinear)
+	_, err := collection.Indexes().CreateOne(mongoCtx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "book", Value: 1},
+			{Key: "chapter", Value: 1},
+			{Key: "verse", Value: 1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating the interlinear index: %v", err)
+	}
+	return bulkWrite(collection, models)
+}
+
+// parseMorphGNT parses a MorphGNT file, one word per line:
+//
+//	010101 N- ----NSF- Βίβλος Βίβλος βίβλος βίβλος
+//
+// with the book (01 is Matthew), chapter and verse, part of speech, parsing
+// code, text, word, normalized word and lemma.
+func parseMorphGNT(r io.Reader) ([]*interlinearVerse, error) {
+	var verses []*interlinearVerse
+	var current *interlinearVerse
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 7 || len(fields[0]) != 6 {
+			return nil, fmt.Errorf("line %d: expected 7 fields", lineNumber)
+		}
+		bcv, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid reference %q", lineNumber, fields[0])
+		}
+		book, chapter, verse := int32(bcv/10000+39), int32(bcv/100%100), int32(bcv%100)
+
+		if current == nil || current.Book != book || current.Chapter != chapter || current.Verse != verse {
+			current = &interlinearVerse{Book: book, Chapter: chapter, Verse: verse, Source: "SBLGNT", Language: "grc"}
+			verses = append(verses, current)
+		}
+		current.Tokens = append(current.Tokens, interlinearToken{
+			Position: int32(len(current.Tokens) + 1),
+			Text:     fields[3],
+			Lemma:    fields[6],
+			Morph:    fields[1] + " " + fields[2],
+		})
+	}
+	return verses, scanner.Err()
+}
+
+// oshbWord is a <w> element of the Open Scriptures Hebrew Bible, the lemma
+// attribute holds the prefixes and Strong's number ("b/7225", "1254 a") and
+// the text separates morphemes with slashes
+type oshbWord struct {
+	Lemma string `xml:"lemma,attr"`
+	Morph string `xml:"morph,attr"`
+	Text  string `xml:",chardata"`
+}
+
+// parseOSHB parses an Open Scriptures Hebrew Bible OSIS book
+func parseOSHB(r io.Reader) ([]*interlinearVerse, error) {
+	var verses []*interlinearVerse
+	var current *interlinearVerse
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return verses, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			switch element.Name.Local {
+			case "verse":
+				ref, err := wsbible.ParseOSIS(xmlAttr(element, "osisID"))
+				if err != nil {
+					return nil, err
+				}
+				current = &interlinearVerse{Book: ref.Book, Chapter: ref.Chapter, Verse: ref.Verse, Source: "OSHB", Language: "hbo"}
+				verses = append(verses, current)
+			case "w":
+				var word oshbWord
+				if err := decoder.DecodeElement(&word, &element); err != nil {
+					return nil, err
+				}
+				if current == nil {
+					continue
+				}
+				current.Tokens = append(current.Tokens, interlinearToken{
+					Position: int32(len(current.Tokens) + 1),
+					Text:     strings.ReplaceAll(word.Text, "/", ""),
+					Strongs:  oshbStrongs(word.Lemma),
+					Morph:    word.Morph,
+				})
+			}
+		case xml.EndElement:
+			if element.Name.Local == "verse" {
+				current = nil
+			}
+		}
+	}
+}
+
+// oshbStrongs takes the Strong's number out of an OSHB lemma attribute,
+// skipping the prefix letters, so "c/d/8064" is H8064 and "1254 a" H1254a
+func oshbStrongs(lemma string) string {
+	parts := strings.Split(lemma, "/")
+	number := strings.ReplaceAll(parts[len(parts)-1], " ", "")
+	number = strings.SplitN(number, "+", 2)[0]
+	strongs, err := wsbible.NormalizeStrongs("H" + number)
+	if err != nil {
+		return ""
+	}
+	return strongs
+}
+
+// xmlAttr returns the value of the named attribute of an element
+func xmlAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
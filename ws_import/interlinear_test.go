@@ -0,0 +1,107 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMorphGNT(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   []*interlinearVerse
+		errMsg string
+	}{
+		{
+			name: "Matthew 1:1",
+			input: "010101 N- ----NSF- Βίβλος Βίβλος βίβλος βίβλος\n" +
+				"010101 N- ----GSF- γενέσεως γενέσεως γενέσεως γένεσις\n",
+			want: []*interlinearVerse{{Book: 40, Chapter: 1, Verse: 1, Source: "SBLGNT", Language: "grc", Tokens: []interlinearToken{
+				{Position: 1, Text: "Βίβλος", Lemma: "βίβλος", Morph: "N- ----NSF-"},
+				{Position: 2, Text: "γενέσεως", Lemma: "γένεσις", Morph: "N- ----GSF-"},
+			}}},
+		},
+		{
+			name:  "Revelation 22:21",
+			input: "272221 N- ----NSF- χάρις χάρις χάρις χάρις\n",
+			want: []*interlinearVerse{{Book: 66, Chapter: 22, Verse: 21, Source: "SBLGNT", Language: "grc", Tokens: []interlinearToken{
+				{Position: 1, Text: "χάρις", Lemma: "χάρις", Morph: "N- ----NSF-"},
+			}}},
+		},
+		{
+			name:  "new verse",
+			input: "040316 C- -------- οὕτως οὕτως οὕτως οὕτως\n\n040317 C- -------- οὐ οὐ οὐ οὐ\n",
+			want: []*interlinearVerse{
+				{Book: 43, Chapter: 3, Verse: 16, Source: "SBLGNT", Language: "grc", Tokens: []interlinearToken{{Position: 1, Text: "οὕτως", Lemma: "οὕτως", Morph: "C- --------"}}},
+				{Book: 43, Chapter: 3, Verse: 17, Source: "SBLGNT", Language: "grc", Tokens: []interlinearToken{{Position: 1, Text: "οὐ", Lemma: "οὐ", Morph: "C- --------"}}},
+			},
+		},
+		{name: "missing fields", input: "010101 N- ----NSF- Βίβλος\n", errMsg: "line 1"},
+		{name: "bad reference", input: "01010x N- ----NSF- Βίβλος Βίβλος βίβλος βίβλος\n", errMsg: "invalid reference"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseMorphGNT(strings.NewReader(test.input))
+			if test.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), test.errMsg) {
+					t.Errorf("got error %v, want %q", err, test.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseOSHB(t *testing.T) {
+	input := `<osis><div type="book" osisID="Gen"><chapter osisID="Gen.1">
+<verse osisID="Gen.1.1"><w lemma="b/7225" morph="HR/Ncfsa">בְּ/רֵאשִׁ֖ית</w> <w lemma="1254 a" morph="HVqp3ms">בָּרָ֣א</w><seg type="x-sof-pasuq">׃</seg></verse>
+<verse osisID="Gen.1.2"><w lemma="c/d/776" morph="HC/Td/Ncbsa">וְ/הָ/אָ֗רֶץ</w></verse>
+</chapter></div></osis>`
+	want := []*interlinearVerse{
+		{Book: 1, Chapter: 1, Verse: 1, Source: "OSHB", Language: "hbo", Tokens: []interlinearToken{
+			{Position: 1, Text: "בְּרֵאשִׁ֖ית", Strongs: "H7225", Morph: "HR/Ncfsa"},
+			{Position: 2, Text: "בָּרָ֣א", Strongs: "H1254a", Morph: "HVqp3ms"},
+		}},
+		{Book: 1, Chapter: 1, Verse: 2, Source: "OSHB", Language: "hbo", Tokens: []interlinearToken{
+			{Position: 1, Text: "וְהָאָ֗רֶץ", Strongs: "H776", Morph: "HC/Td/Ncbsa"},
+		}},
+	}
+	got, err := parseOSHB(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, err := parseOSHB(strings.NewReader(`<verse osisID="Foo.1.1"></verse>`)); err == nil {
+		t.Errorf("parsed a verse of an unknown book")
+	}
+}
+
+func TestOSHBStrongs(t *testing.T) {
+	tests := []struct {
+		lemma, want string
+	}{
+		{"7225", "H7225"},
+		{"b/7225", "H7225"},
+		{"c/d/8064", "H8064"},
+		{"1254 a", "H1254a"},
+		{"1254 A", "H1254a"},
+		{"l/430+", "H430"},
+		{"c", ""},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if got := oshbStrongs(test.lemma); got != test.want {
+			t.Errorf("oshbStrongs(%q) = %q, want %q", test.lemma, got, test.want)
+		}
+	}
+}
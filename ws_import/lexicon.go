@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// lexiconEntry is a document in the lexicon collection
+type lexiconEntry struct {
+	Strongs         string `bson:"strongs"`
+	Lemma           string `bson:"lemma"`
+	Transliteration string `bson:"transliteration"`
+	Pronunciation   string `bson:"pronunciation"`
+	Definition      string `bson:"definition"`
+	KJVUsage        string `bson:"kjv_usage"`
+	Derivation      string `bson:"derivation"`
+}
+
+// openScripturesEntry is an entry of the Open Scriptures Strong's Hebrew and
+// Greek dictionaries, the Hebrew one uses xlit where the Greek uses translit
+type openScripturesEntry struct {
+	Lemma      string `json:"lemma"`
+	Translit   string `json:"translit"`
+	Xlit       string `json:"xlit"`
+	Pron       string `json:"pron"`
+	Derivation string `json:"derivation"`
+	StrongsDef string `json:"strongs_def"`
+	KJVDef     string `json:"kjv_def"`
+}
+
+// importLexicon reads an Open Scriptures Strong's dictionary, either the JSON
+// object keyed by Strong's number or the published .js file wrapping it, and
+// upserts one lexicon document per number.
+func importLexicon(args []string) error {
+	flags := flag.NewFlagSet("lexicon", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "parse the file and report without writing")
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("lexicon needs exactly one file")
+	}
+
+	data, err := ioutil.ReadFile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	// strip the "var strongsGreekDictionary = " wrapper of the .js files
+	start, end := strings.Index(string(data), "{"), strings.LastIndex(string(data), "}")
+	if start < 0 || end < start {
+		return fmt.Errorf("%s does not contain a JSON object", flags.Arg(0))
+	}
+	var dictionary map[string]openScripturesEntry
+	if err := json.Unmarshal(data[start:end+1], &dictionary); err != nil {
+		return fmt.Errorf("error parsing the dictionary: %v", err)
+	}
+
+	var numbers []string
+	for number := range dictionary {
+		numbers = append(numbers, number)
+	}
+	sort.Strings(numbers)
+
+	var models []mongo.WriteModel
+	for _, number := range numbers {
+		strongs, err := wsbible.NormalizeStrongs(number)
+		if err != nil {
+			fmt.Printf("Entry skipped: %v\n", err)
+			continue
+		}
+		entry := dictionary[number]
+		transliteration := entry.Translit
+		if transliteration == "" {
+			transliteration = entry.Xlit
+		}
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"strongs": strongs}).
+			SetReplacement(lexiconEntry{
+				Strongs:         strongs,
+				Lemma:           entry.Lemma,
+				Transliteration: transliteration,
+				Pronunciation:   entry.Pron,
+				Definition:      strings.TrimSpace(entry.StrongsDef),
+				KJVUsage:        strings.TrimSpace(entry.KJVDef),
+				Derivation:      strings.TrimSpace(entry.Derivation),
+			}).
+			SetUpsert(true))
+	}
+	fmt.Printf("Parsed %d lexicon entries\n", len(models))
+	if *dryRun {
+		return nil
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
+	collection := db.Database(config.Database).Collection(config.Collections.Lexicon)
+	_, err = collection.Indexes().CreateOne(mongoCtx, mongo.IndexModel{
+		Keys:    bson.M{"strongs": 1},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating the lexicon index: %v", err)
+	}
+	return bulkWrite(collection, models)
+}
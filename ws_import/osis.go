@@ -0,0 +1,180 @@
+package main
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+)
+
+// osisSkippedTitles are title types that are not section headings
+var osisSkippedTitles = map[string]bool{
+	"main":        true,
+	"chapter":     true,
+	"runningHead": true,
+}
+
+// parseOSISBible reads an OSIS XML Bible. Verses may be containers or sID/eID
+// milestones, and the markup kept is:
+//
+//	<q who="Jesus">          words of Christ, container or milestone
+//	<transChange type="added"> words added by the translators
+//	<divineName>             LORD
+//	<w lemma="strong:H7225"> Strong's numbers
+//	<note>                   footnotes, type="variant" is an alternate reading and
+//	                         type="x-literal" a literal one, cross references are dropped
+//	<title>                  section headings between verses
+func parseOSISBible(r io.Reader) (*parsedBible, error) {
+	c := newBibleCollector()
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+
+	var ends []func()         // run at the end of each open element
+	var note *strings.Builder // text of the note being read
+	var noteType string
+	var heading *strings.Builder // text of the heading being read
+	skip := 0                    // depth inside elements whose text is dropped
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			var end func()
+			switch t.Name.Local {
+			case "div":
+				if xmlAttr(t, "type") == "book" {
+					c.endVerse()
+					end = c.endVerse
+				}
+			case "verse":
+				if xmlAttr(t, "eID") != "" {
+					c.endVerse()
+					break
+				}
+				ids := strings.Fields(xmlAttr(t, "osisID"))
+				if len(ids) == 0 {
+					break
+				}
+				name, book, chapter, number := osisVerse(ids[0])
+				c.startVerse(name, book, chapter, number)
+				if xmlAttr(t, "sID") == "" {
+					end = c.endVerse
+				}
+			case "q":
+				if id := xmlAttr(t, "eID"); id != "" {
+					c.closeSpan(id, "")
+				} else if xmlAttr(t, "who") == "Jesus" {
+					if id := xmlAttr(t, "sID"); id != "" {
+						c.openSpan(id, wsbible.SpanWordsOfChrist)
+					} else {
+						c.openSpan("", wsbible.SpanWordsOfChrist)
+						end = func() { c.closeSpan("", wsbible.SpanWordsOfChrist) }
+					}
+				}
+			case "transChange":
+				if xmlAttr(t, "type") == "added" {
+					c.openSpan("", wsbible.SpanAdded)
+					end = func() { c.closeSpan("", wsbible.SpanAdded) }
+				}
+			case "divineName":
+				c.openSpan("", wsbible.SpanDivineName)
+				end = func() { c.closeSpan("", wsbible.SpanDivineName) }
+			case "w":
+				var strongs []string
+				for _, lemma := range strings.Fields(xmlAttr(t, "lemma")) {
+					if strings.HasPrefix(lemma, "strong:") {
+						strongs = append(strongs, strings.TrimPrefix(lemma, "strong:"))
+					}
+				}
+				if c.current != nil && len(strongs) > 0 {
+					verse, start := c.current, c.current.offset()
+					end = func() { verse.tag(start, strongs) }
+				}
+			case "note":
+				switch xmlAttr(t, "type") {
+				case "crossReference":
+					skip++
+					end = func() { skip-- }
+				default:
+					noteType = osisNoteType(xmlAttr(t, "type"))
+					note = &strings.Builder{}
+					end = func() {
+						if c.current != nil {
+							c.current.note(noteType, note.String())
+						}
+						note = nil
+					}
+				}
+			case "title":
+				if osisSkippedTitles[xmlAttr(t, "type")] {
+					skip++
+					end = func() { skip-- }
+				} else if c.current == nil {
+					heading = &strings.Builder{}
+					end = func() {
+						c.heading(heading.String())
+						heading = nil
+					}
+				}
+			case "header":
+				skip++
+				end = func() { skip-- }
+			}
+			ends = append(ends, end)
+
+		case xml.EndElement:
+			if len(ends) == 0 {
+				break
+			}
+			end := ends[len(ends)-1]
+			ends = ends[:len(ends)-1]
+			if end != nil {
+				end()
+			}
+
+		case xml.CharData:
+			switch {
+			case skip > 0:
+			case note != nil:
+				note.Write(t)
+			case heading != nil:
+				heading.Write(t)
+			default:
+				c.write(string(t))
+			}
+		}
+	}
+
+	return c.finish(), nil
+}
+
+// osisVerse splits an OSIS verse id, returning book 0 for books outside the canon
+func osisVerse(id string) (string, int32, int32, int32) {
+	parts := strings.Split(id, ".")
+	book, ok := wsbible.BookByOSIS(parts[0])
+	if !ok || len(parts) != 3 {
+		return parts[0], 0, 0, 0
+	}
+	chapter, _ := strconv.Atoi(parts[1])
+	number, _ := strconv.Atoi(parts[2])
+	return book.Name, book.Number, int32(chapter), int32(number)
+}
+
+// osisNoteType maps an OSIS note type onto a footnote type
+func osisNoteType(osisType string) string {
+	switch osisType {
+	case "variant", "alternative":
+		return "alternate"
+	case "x-literal":
+		return "literal"
+	}
+	return "note"
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// pericope is a document in the pericope collection
+type pericope struct {
+	Translation string `bson:"translation"`
+	Book        int32  `bson:"book"`
+	Chapter     int32  `bson:"chapter"`
+	Verse       int32  `bson:"verse"`
+	Title       string `bson:"title"`
+}
+
+// importPericopes reads the section headings of a translation, one per line
+// with the OSIS id of the verse the section starts at and its title:
+//
+//	Matt.5.1	The Sermon on the Mount
+//
+// The headings already stored for the translation in the books of the file
+// are replaced, so the import can be re-run after editing titles.
+func importPericopes(args []string) error {
+	flags := flag.NewFlagSet("pericopes", flag.ExitOnError)
+	translation := flags.String("translation", "", "translation code the headings belong to, blank for the default text")
+	dryRun := flags.Bool("dry-run", false, "parse the file and report without writing")
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("pericopes needs exactly one file")
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var pericopes []pericope
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.SplitN(line, "\t", 2)
+		if len(fields) != 2 || strings.TrimSpace(fields[1]) == "" {
+			return fmt.Errorf("line %d: expected a verse and a title", lineNumber)
+		}
+		ref, err := wsbible.ParseOSIS(fields[0])
+		if err != nil {
+			return fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+		if ref.Verse == 0 {
+			ref.Verse = 1
+		}
+		pericopes = append(pericopes, pericope{
+			Translation: *translation,
+			Book:        ref.Book,
+			Chapter:     ref.Chapter,
+			Verse:       ref.Verse,
+			Title:       strings.TrimSpace(fields[1]),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	fmt.Printf("Parsed %d section headings\n", len(pericopes))
+	if *dryRun {
+		return nil
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
+	return replacePericopes(*translation, pericopes)
+}
+
+// replacePericopes replaces the headings of the translation in the books the
+// pericopes cover
+func replacePericopes(translation string, pericopes []pericope) error {
+	collection := db.Database(config.Database).Collection(config.Collections.Pericope)
+
+	var books bson.A
+	seen := make(map[int32]bool)
+	var models []mongo.WriteModel
+	for _, p := range pericopes {
+		if !seen[p.Book] {
+			seen[p.Book] = true
+			books = append(books, p.Book)
+		}
+		models = append(models, mongo.NewInsertOneModel().SetDocument(p))
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	filter := bson.M{"translation": translation, "book": bson.M{"$in": books}}
+	if translation == "" {
+		filter["translation"] = bson.M{"$in": bson.A{"", nil}}
+	}
+	deleted, err := collection.DeleteMany(mongoCtx, filter)
+	if err != nil {
+		return fmt.Errorf("error removing the old headings: %v", err)
+	}
+	fmt.Printf("Removed %d old section headings\n", deleted.DeletedCount)
+	return bulkWrite(collection, models)
+}
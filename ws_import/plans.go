@@ -0,0 +1,135 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// plan is a document in the readingplan collection, one track of a plan
+type plan struct {
+	Name   string   `bson:"name"`
+	Number int32    `bson:"number"`
+	Days   []string `bson:"days"`
+}
+
+// importPlans loads reading plans from CSV (a day column and one column per
+// track) or JSON (a list of tracks with name, number and days), replacing the
+// tracks of the plans in the file. Every reading is parsed as a reference
+// and nothing is written if any are invalid. With -export it writes a plan
+// from the collection to the file instead.
+func importPlans(args []string) error {
+	flags := flag.NewFlagSet("plans", flag.ExitOnError)
+	format := flags.String("format", "", "csv or json, defaults to the file extension")
+	name := flags.String("name", "", "plan name, required for csv and replacing the names of a json file")
+	export := flags.Bool("export", false, "write the plan named -name to the file instead of importing it")
+	dryRun := flags.Bool("dry-run", false, "validate the file without writing")
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("plans needs one file")
+	}
+	fileName := flags.Arg(0)
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileName)), ".")
+	}
+
+	if *export {
+		return exportPlan(fileName, *format, *name)
+	}
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	tracks, err := wsbible.ReadPlan(file, *format, *name)
+	if err != nil {
+		return err
+	}
+
+	days := 0
+	for _, track := range tracks {
+		if len(track.Days) > days {
+			days = len(track.Days)
+		}
+	}
+	fmt.Printf("Read %d tracks of up to %d days\n", len(tracks), days)
+	if planErrs := wsbible.ValidatePlan(tracks); len(planErrs) > 0 {
+		for _, planErr := range planErrs {
+			fmt.Println("  " + planErr.Error())
+		}
+		return fmt.Errorf("%d invalid readings, nothing was imported", len(planErrs))
+	}
+	if *dryRun {
+		return nil
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
+	collection := db.Database(config.Database).Collection(config.Collections.ReadingPlan)
+	var names bson.A
+	seen := make(map[string]bool)
+	var models []mongo.WriteModel
+	for _, track := range tracks {
+		if !seen[track.Name] {
+			seen[track.Name] = true
+			names = append(names, track.Name)
+		}
+		models = append(models, mongo.NewInsertOneModel().SetDocument(plan{
+			Name:   track.Name,
+			Number: track.Number,
+			Days:   track.Days,
+		}))
+	}
+	deleted, err := collection.DeleteMany(mongoCtx, bson.M{"name": bson.M{"$in": names}})
+	if err != nil {
+		return fmt.Errorf("error removing the old plan tracks: %v", err)
+	}
+	fmt.Printf("Removed %d old plan tracks\n", deleted.DeletedCount)
+	return bulkWrite(collection, models)
+}
+
+// exportPlan writes the tracks of a plan to a file
+func exportPlan(fileName, format, name string) error {
+	if name == "" {
+		return fmt.Errorf("export needs the -name of the plan")
+	}
+	if err := connect(); err != nil {
+		return err
+	}
+	cursor, err := db.Database(config.Database).Collection(config.Collections.ReadingPlan).
+		Find(mongoCtx, bson.M{"name": name}, options.Find().SetSort(bson.M{"number": 1}))
+	if err != nil {
+		return err
+	}
+	var plans []plan
+	if err := cursor.All(mongoCtx, &plans); err != nil {
+		return err
+	}
+	if len(plans) == 0 {
+		return fmt.Errorf("there is no plan named %q", name)
+	}
+
+	var tracks []wsbible.PlanTrack
+	for _, p := range plans {
+		tracks = append(tracks, wsbible.PlanTrack{Name: p.Name, Number: p.Number, Days: p.Days})
+	}
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	if err := wsbible.WritePlan(file, format, tracks); err != nil {
+		file.Close()
+		return err
+	}
+	fmt.Printf("Wrote %d tracks of %s to %s\n", len(tracks), name, fileName)
+	return file.Close()
+}
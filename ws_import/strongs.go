@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// word is a tagged word stored in the words array of a verse document
+type word struct {
+	Text    string   `bson:"text"`
+	Strongs []string `bson:"strongs,omitempty"`
+}
+
+// taggedToken splits KJV+ text into markup tags and words
+var taggedToken = regexp.MustCompile(`<[^>]*>|\{[^}]*\}|[^\s<{]+`)
+
+// strongsTag matches the Strong's tags of the common KJV+ formats, <WH7225>,
+// <H7225> and {H7225}, other tags (like <WTH8804> morphology) are dropped
+var strongsTag = regexp.MustCompile(`^[<{]W?([HGhg]\d+[a-z]?)[>}]$`)
+
+// importStrongs reads a verse per line KJV+ file and stores the per word
+// Strong's tagging on the matching verse documents. Each line is an OSIS
+// verse id or book, chapter and verse numbers, then the tagged text, all tab
+// separated:
+//
+//	Gen.1.1	In the beginning<WH7225> God<WH430> created<WH1254><WH853> ...
+//	1	1	1	In the beginning{H7225} God{H430} created{H1254}{H853} ...
+//
+// The verse text itself is left alone, only the words array of the verses
+// of the -translation is replaced.
+func importStrongs(args []string) error {
+	flags := flag.NewFlagSet("strongs", flag.ExitOnError)
+	translation := flags.String("translation", "", "translation code of the tagged text, blank for the default text")
+	dryRun := flags.Bool("dry-run", false, "parse the file and report without writing")
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("strongs needs exactly one file")
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var models []mongo.WriteModel
+	tagged, skipped := 0, 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		ref, text, err := parseTaggedLine(line)
+		if err != nil {
+			fmt.Printf("Line %d skipped: %v\n", lineNumber, err)
+			skipped++
+			continue
+		}
+		words := parseTaggedText(text)
+		for _, w := range words {
+			tagged += len(w.Strongs)
+		}
+		filter := bson.M{
+			"translation": *translation,
+			"book":        ref.Book,
+			"chapter":     ref.Chapter,
+			"verse":       ref.Verse,
+		}
+		if *translation == "" {
+			filter["translation"] = bson.M{"$in": bson.A{"", nil}}
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(bson.M{"$set": bson.M{"words": words}}))
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	fmt.Printf("Parsed %d verses with %d Strong's tags, skipped %d lines\n", len(models), tagged, skipped)
+	if *dryRun {
+		return nil
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
+	collection := db.Database(config.Database).Collection(config.Collections.Verse)
+	_, err = collection.Indexes().CreateOne(mongoCtx, mongo.IndexModel{
+		Keys: bson.M{"words.strongs": 1},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating the Strong's index: %v", err)
+	}
+	return bulkWrite(collection, models)
+}
+
+// parseTaggedLine splits a line into its verse reference and tagged text
+func parseTaggedLine(line string) (wsbible.Reference, string, error) {
+	fields := strings.Split(line, "\t")
+	switch len(fields) {
+	case 2:
+		ref, err := wsbible.ParseOSIS(fields[0])
+		if err == nil && ref.Verse == 0 {
+			err = fmt.Errorf("%q is not a verse", fields[0])
+		}
+		return ref, fields[1], err
+	case 4:
+		var numbers [3]int32
+		for i, field := range fields[:3] {
+			n, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil || n < 1 {
+				return wsbible.Reference{}, "", fmt.Errorf("invalid verse number %q", field)
+			}
+			numbers[i] = int32(n)
+		}
+		return wsbible.Reference{Book: numbers[0], Chapter: numbers[1], Verse: numbers[2]}, fields[3], nil
+	}
+	return wsbible.Reference{}, "", fmt.Errorf("expected 2 or 4 tab separated fields, found %d", len(fields))
+}
+
+// parseTaggedText splits KJV+ text into words, attaching each Strong's tag to
+// the word before it
+func parseTaggedText(text string) []word {
+	var words []word
+	for _, token := range taggedToken.FindAllString(text, -1) {
+		if token[0] == '<' || token[0] == '{' {
+			match := strongsTag.FindStringSubmatch(token)
+			if match == nil || len(words) == 0 {
+				continue
+			}
+			if strongs, err := wsbible.NormalizeStrongs(match[1]); err == nil {
+				last := &words[len(words)-1]
+				last.Strongs = append(last.Strongs, strongs)
+			}
+			continue
+		}
+
+		token = strings.TrimFunc(token, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		if token != "" {
+			words = append(words, word{Text: token})
+		}
+	}
+	return words
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// translation is a document in the translation collection
+type translation struct {
+	Code     string `bson:"code"`
+	Name     string `bson:"name"`
+	Language string `bson:"language"`
+	Analyzer string `bson:"analyzer"`
+}
+
+// importTranslation registers a translation with its text analyzer and runs
+// the indexing pipeline over its verses, storing the analyzed text in
+// text_folded and the words of Christ in text_woc for Search. Re-run it after
+// changing the analyzer or the verse markup.
+func importTranslation(args []string) error {
+	flags := flag.NewFlagSet("translation", flag.ExitOnError)
+	code := flags.String("code", "", "translation code stored on its verses, i.e. KJV, SBLGNT")
+	name := flags.String("name", "", "display name")
+	language := flags.String("language", "en", "language code, i.e. en, grc, hbo")
+	analyzer := flags.String("analyzer", wsbible.AnalyzerStandard, "text analyzer: standard, greek or hebrew")
+	_ = flags.Parse(args)
+	if *code == "" {
+		return fmt.Errorf("translation needs a -code")
+	}
+	known := false
+	for _, a := range wsbible.Analyzers {
+		known = known || a == *analyzer
+	}
+	if !known {
+		return fmt.Errorf("unknown analyzer %q, use one of %v", *analyzer, wsbible.Analyzers)
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
+	_, err := db.Database(config.Database).Collection(config.Collections.Translation).ReplaceOne(mongoCtx,
+		bson.M{"code": *code},
+		translation{Code: *code, Name: *name, Language: *language, Analyzer: *analyzer},
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("error saving the translation: %v", err)
+	}
+	fmt.Printf("Translation %s saved with the %s analyzer\n", *code, *analyzer)
+
+	return reindexTranslation(*code, *analyzer)
+}
+
+// reindexTranslation recomputes the search fields of every verse of the translation
+func reindexTranslation(code, analyzer string) error {
+	collection := db.Database(config.Database).Collection(config.Collections.Verse)
+	cursor, err := collection.Find(mongoCtx, bson.M{"translation": code},
+		options.Find().SetProjection(bson.M{"text": 1, "spans": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(mongoCtx)
+
+	var models []mongo.WriteModel
+	for cursor.Next(mongoCtx) {
+		var verse struct {
+			ID    interface{}    `bson:"_id"`
+			Text  string         `bson:"text"`
+			Spans []wsbible.Span `bson:"spans"`
+		}
+		if err := cursor.Decode(&verse); err != nil {
+			return err
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": verse.ID}).
+			SetUpdate(searchFields(analyzer, verse.Text, verse.Spans)))
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	fmt.Printf("Reindexing %d verses of %s\n", len(models), code)
+	return bulkWrite(collection, models)
+}
+
+// searchFields builds the update setting the search fields of a verse, the
+// folded text for folding analyzers and the words of Christ, and unsetting
+// the ones that do not apply
+func searchFields(analyzer, text string, spans []wsbible.Span) bson.M {
+	set, unset := bson.M{}, bson.M{}
+
+	if wsbible.Folds(analyzer) {
+		set["text_folded"] = wsbible.Fold(analyzer, text)
+	} else {
+		unset["text_folded"] = ""
+	}
+
+	if woc := wsbible.SpanText(text, spans, wsbible.SpanWordsOfChrist); woc != "" {
+		if wsbible.Folds(analyzer) {
+			woc = wsbible.Fold(analyzer, woc)
+		}
+		set["text_woc"] = woc
+	} else {
+		unset["text_woc"] = ""
+	}
+
+	update := bson.M{}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	return update
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+)
+
+// usfmMarker matches a USFM marker, \v, \wj, \+nd, \f* ...
+var usfmMarker = regexp.MustCompile(`\\\+?[a-z]+[0-9]*\*?`)
+
+// usfmStrong matches the strong attribute of a \w word
+var usfmStrong = regexp.MustCompile(`strong="([^"]*)"`)
+
+// usfmHeadings are the paragraph markers whose line is a section heading
+var usfmHeadings = map[string]bool{
+	"s": true, "s1": true, "s2": true, "s3": true, "s4": true,
+	"ms": true, "ms1": true, "ms2": true, "ms3": true,
+	"d": true,
+}
+
+// usfmSkipped are the paragraph markers whose line is not Bible text, book
+// titles, introductions, parallel passage references and remarks
+var usfmSkipped = map[string]bool{
+	"ide": true, "h": true, "toc1": true, "toc2": true, "toc3": true,
+	"mt": true, "mt1": true, "mt2": true, "mt3": true, "mte": true,
+	"imt": true, "imt1": true, "imt2": true, "is": true, "is1": true, "is2": true,
+	"ip": true, "ipi": true, "im": true, "io": true, "io1": true, "io2": true,
+	"ie": true, "mr": true, "r": true, "rem": true, "sts": true, "usfm": true,
+	"cl": true, "cp": true, "cd": true, "sr": true,
+}
+
+// usfmSpans are the character markers kept as markup spans
+var usfmSpans = map[string]string{
+	"wj":  wsbible.SpanWordsOfChrist,
+	"add": wsbible.SpanAdded,
+	"nd":  wsbible.SpanDivineName,
+}
+
+// parseUSFMBible reads a USFM book (or several concatenated). The markup
+// kept is:
+//
+//	\s \ms \d                  section headings
+//	\wj ...\wj*                words of Christ
+//	\add ...\add*              words added by the translators
+//	\nd ...\nd*                LORD
+//	\w word|strong="H7225"\w*  Strong's numbers
+//	\f + \fr 1:1 \ft ...\f*    footnotes, \fqa marks an alternate reading
+//
+// Cross references (\x ...\x*) are dropped along with the book introductions.
+func parseUSFMBible(r io.Reader) (*parsedBible, error) {
+	p := &usfmParser{c: newBibleCollector()}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		p.line(strings.TrimSpace(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return p.c.finish(), nil
+}
+
+// usfmParser holds the state of a USFM parse across lines
+type usfmParser struct {
+	c       *bibleCollector
+	name    string
+	book    int32
+	chapter int32
+
+	verseNext bool             // the next text starts with a verse number
+	note      *strings.Builder // text of the footnote being read
+	noteType  string
+	noteRef   bool             // inside the \fr reference of a footnote
+	crossRef  bool             // inside a \x cross reference
+	word      *strings.Builder // text and attributes of the \w word being read
+}
+
+// line handles one line of the file
+func (p *usfmParser) line(line string) {
+	if !strings.HasPrefix(line, `\`) {
+		p.text(line + " ")
+		return
+	}
+	marker := strings.TrimPrefix(strings.Fields(line)[0], `\`)
+	rest := strings.TrimSpace(strings.TrimPrefix(line, `\`+marker))
+
+	switch {
+	case marker == "id":
+		p.c.endVerse()
+		p.name = strings.Fields(rest + " ?")[0]
+		p.book, p.chapter = 0, 0
+		if book, ok := wsbible.BookByUSFM(p.name); ok {
+			p.name, p.book = book.Name, book.Number
+		}
+	case marker == "c":
+		p.c.endVerse()
+		chapter, _ := strconv.Atoi(strings.Fields(rest + " 0")[0])
+		p.chapter = int32(chapter)
+	case usfmHeadings[marker]:
+		p.c.endVerse()
+		p.c.heading(usfmPlain(rest))
+	case usfmSkipped[marker]:
+	default:
+		p.text(line + " ")
+	}
+}
+
+// text handles running text with inline markers
+func (p *usfmParser) text(text string) {
+	last := 0
+	for _, loc := range usfmMarker.FindAllStringIndex(text, -1) {
+		p.write(text[last:loc[0]])
+		last = loc[1]
+		marker := text[loc[0]:loc[1]]
+		// the space after an opening marker belongs to the marker
+		if !strings.HasSuffix(marker, "*") && last < len(text) && text[last] == ' ' {
+			last++
+		}
+		p.marker(marker)
+	}
+	p.write(text[last:])
+}
+
+// marker handles an inline marker
+func (p *usfmParser) marker(marker string) {
+	name := strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(marker, `\`), "+"), "*")
+	closing := strings.HasSuffix(marker, "*")
+
+	switch {
+	case name == "v":
+		p.verseNext = true
+	case name == "x":
+		p.crossRef = !closing
+	case p.crossRef:
+	case name == "f" || name == "fe":
+		if !closing {
+			// the caller (+, - or a letter) is dropped like the reference
+			p.note, p.noteType, p.noteRef = &strings.Builder{}, "note", true
+			return
+		}
+		if p.note != nil && p.c.current != nil {
+			p.c.current.note(p.noteType, p.note.String())
+		}
+		p.note = nil
+	case p.note != nil:
+		// footnote fields, the reference is dropped and \fqa is an alternate reading
+		p.noteRef = name == "fr"
+		if name == "fqa" && !closing {
+			p.noteType = "alternate"
+		}
+	case name == "w":
+		if !closing {
+			p.word = &strings.Builder{}
+			return
+		}
+		if p.word != nil {
+			p.writeWord(p.word.String())
+		}
+		p.word = nil
+	case usfmSpans[name] != "":
+		if closing {
+			p.c.closeSpan("", usfmSpans[name])
+		} else {
+			p.c.openSpan("", usfmSpans[name])
+		}
+	case !closing:
+		// paragraph and poetry markers separate words
+		p.c.write(" ")
+	}
+}
+
+// write handles text between markers
+func (p *usfmParser) write(text string) {
+	if p.verseNext {
+		text = strings.TrimLeft(text, " ")
+		if text == "" {
+			return
+		}
+		p.verseNext = false
+		fields := strings.SplitN(text, " ", 2)
+		// bridged verses (\v 1-2) are stored under the first
+		number, _ := strconv.Atoi(strings.Split(fields[0], "-")[0])
+		p.c.startVerse(p.name, p.book, p.chapter, int32(number))
+		if len(fields) == 1 {
+			return
+		}
+		text = fields[1]
+	}
+
+	switch {
+	case p.crossRef:
+	case p.note != nil:
+		if !p.noteRef {
+			p.note.WriteString(text)
+		}
+	case p.word != nil:
+		p.word.WriteString(text)
+	default:
+		p.c.write(text)
+	}
+}
+
+// writeWord writes a \w word and tags it with its Strong's numbers
+func (p *usfmParser) writeWord(content string) {
+	parts := strings.SplitN(content, "|", 2)
+	if p.c.current == nil {
+		return
+	}
+	start := p.c.current.offset()
+	p.c.write(parts[0])
+	if len(parts) < 2 {
+		return
+	}
+	if match := usfmStrong.FindStringSubmatch(parts[1]); match != nil {
+		p.c.current.tag(start, strings.FieldsFunc(match[1], func(r rune) bool {
+			return r == ',' || r == ' '
+		}))
+	}
+}
+
+// usfmPlain strips the markers and footnotes from a heading
+func usfmPlain(text string) string {
+	p := &usfmParser{c: newBibleCollector()}
+	p.c.current = newVerseBuilder(0, 0, 0)
+	p.text(text)
+	return string(p.c.current.text)
+}
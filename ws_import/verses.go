@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// verseList is a document in the verselist collection
+type verseList struct {
+	Name       string   `bson:"name"`
+	References []string `bson:"references"`
+}
+
+// importVerses reads a verse list for the VerseOfTheDay RPC, one passage per
+// line in the usual form:
+//
+//	Joshua 1:9
+//	Isa 41:10
+//	Rom 8:38-39
+//
+// The list replaces any stored list of the same name. Changing the number of
+// passages changes the rotation of the days still to come.
+func importVerses(args []string) error {
+	flags := flag.NewFlagSet("verses", flag.ExitOnError)
+	name := flags.String("name", "", "name of the list, i.e. encouragement or memory")
+	dryRun := flags.Bool("dry-run", false, "parse the file and report without writing")
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("verses needs exactly one file")
+	}
+	if *name == "" {
+		return fmt.Errorf("verses needs a -name")
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	list := verseList{Name: *name}
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ref, err := wsbible.ParseReference(line)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+		list.References = append(list.References, ref.String())
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(list.References) == 0 {
+		return fmt.Errorf("the list has no passages")
+	}
+	fmt.Printf("Parsed %d passages for %s\n", len(list.References), *name)
+	if *dryRun {
+		return nil
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
+	_, err = db.Database(config.Database).Collection(config.Collections.VerseList).ReplaceOne(mongoCtx,
+		bson.M{"name": *name}, list, options.Replace().SetUpsert(true))
+	return err
+}
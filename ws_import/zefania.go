@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+)
+
+// parseZefaniaBible reads a Zefania XML Bible. Books are numbered 1 to 66 as
+// in the verse collection, higher numbers (the Apocrypha) are unmapped. The
+// markup kept is:
+//
+//	<CAPTION>                    section headings before a verse
+//	<NOTE>                       footnotes
+//	<gr str="7225">              Strong's numbers, Hebrew or Greek by testament
+//	<STYLE fs="italic">          words added by the translators
+//	<STYLE fs="divineName">      LORD
+//	<STYLE css="color:#ff0000">  words of Christ
+func parseZefaniaBible(r io.Reader) (*parsedBible, error) {
+	c := newBibleCollector()
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+
+	var ends []func()         // run at the end of each open element
+	var note *strings.Builder // text of the note being read
+	var heading *strings.Builder
+	skip := 0 // depth inside elements whose text is dropped
+	var name string
+	var book, chapter int32
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			var end func()
+			switch strings.ToUpper(t.Name.Local) {
+			case "BIBLEBOOK":
+				c.endVerse()
+				number, _ := strconv.Atoi(xmlAttr(t, "bnumber"))
+				book, name = 0, xmlAttr(t, "bname")
+				if known, ok := wsbible.BookByNumber(int32(number)); ok {
+					book, name = known.Number, known.Name
+				} else if name == "" {
+					name = "book " + strconv.Itoa(number)
+				}
+				end = c.endVerse
+			case "CHAPTER":
+				number, _ := strconv.Atoi(xmlAttr(t, "cnumber"))
+				chapter = int32(number)
+			case "VERS":
+				number, _ := strconv.Atoi(xmlAttr(t, "vnumber"))
+				c.startVerse(name, book, chapter, int32(number))
+				end = c.endVerse
+			case "CAPTION":
+				heading = &strings.Builder{}
+				end = func() {
+					c.heading(heading.String())
+					heading = nil
+				}
+			case "NOTE":
+				note = &strings.Builder{}
+				end = func() {
+					if c.current != nil {
+						c.current.note("note", note.String())
+					}
+					note = nil
+				}
+			case "XREF", "INFORMATION":
+				skip++
+				end = func() { skip-- }
+			case "BR":
+				c.write(" ")
+			case "STYLE":
+				if spanType := zefaniaSpan(t); spanType != "" {
+					c.openSpan("", spanType)
+					end = func() { c.closeSpan("", spanType) }
+				}
+			case "GR":
+				prefix := "G"
+				if book <= 39 {
+					prefix = "H"
+				}
+				var strongs []string
+				for _, number := range strings.Fields(xmlAttr(t, "str")) {
+					strongs = append(strongs, prefix+number)
+				}
+				if c.current != nil && len(strongs) > 0 {
+					verse, start := c.current, c.current.offset()
+					end = func() { verse.tag(start, strongs) }
+				}
+			}
+			ends = append(ends, end)
+
+		case xml.EndElement:
+			if len(ends) == 0 {
+				break
+			}
+			end := ends[len(ends)-1]
+			ends = ends[:len(ends)-1]
+			if end != nil {
+				end()
+			}
+
+		case xml.CharData:
+			switch {
+			case skip > 0:
+			case note != nil:
+				note.Write(t)
+			case heading != nil:
+				heading.Write(t)
+			default:
+				c.write(string(t))
+			}
+		}
+	}
+
+	return c.finish(), nil
+}
+
+// zefaniaSpan maps a STYLE element onto a markup span type, blank if it is
+// only presentation
+func zefaniaSpan(style xml.StartElement) string {
+	switch xmlAttr(style, "fs") {
+	case "italic":
+		return wsbible.SpanAdded
+	case "divineName":
+		return wsbible.SpanDivineName
+	}
+	css := strings.ToLower(xmlAttr(style, "css"))
+	if strings.Contains(css, "red") || strings.Contains(css, "#ff0000") || strings.Contains(css, "#f00") {
+		return wsbible.SpanWordsOfChrist
+	}
+	return ""
+}
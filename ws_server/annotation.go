@@ -0,0 +1,378 @@
+package main
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Annotation struct for the annotation collection, a bookmark, highlight or
+// note of a user on a range of verses in one book
+type Annotation struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	UserID     primitive.ObjectID `bson:"user_id"`
+	Type       string             `bson:"type"`
+	Book       int32              `bson:"book"`
+	Chapter    int32              `bson:"chapter"`
+	Verse      int32              `bson:"verse"`
+	EndChapter int32              `bson:"end_chapter"`
+	EndVerse   int32              `bson:"end_verse"`
+	Color      string             `bson:"color,omitempty"`
+	Note       string             `bson:"note,omitempty"`
+	Tags       []string           `bson:"tags"`
+	Created    time.Time          `bson:"created"`
+	Updated    time.Time          `bson:"updated"`
+}
+
+// annotationTypes are the kinds of annotation
+var annotationTypes = map[string]bool{
+	"bookmark":  true,
+	"highlight": true,
+	"note":      true,
+}
+
+// highlightColors are the named highlight colors, any #rrggbb is accepted too
+var highlightColors = map[string]bool{
+	"yellow": true,
+	"green":  true,
+	"blue":   true,
+	"pink":   true,
+	"orange": true,
+	"purple": true,
+}
+
+var hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Annotation list limits
+const (
+	defaultAnnotationLimit = 100
+	maxAnnotationLimit     = 500
+)
+
+// listLimit clamps the limit of a list request to max, 0 or less taking the default
+func listLimit(limit, defaultLimit, max int32) int64 {
+	if limit < 1 {
+		return int64(defaultLimit)
+	}
+	if limit > max {
+		return int64(max)
+	}
+	return int64(limit)
+}
+
+// proto converts the annotation into its protocol buffer message
+func (a *Annotation) proto() *wordsearcher.Annotation {
+	passage := wsbible.Reference{
+		Book:       a.Book,
+		Chapter:    a.Chapter,
+		Verse:      a.Verse,
+		EndBook:    a.Book,
+		EndChapter: a.EndChapter,
+		EndVerse:   a.EndVerse,
+	}
+	return &wordsearcher.Annotation{
+		Id:         a.ID.Hex(),
+		Type:       a.Type,
+		Book:       a.Book,
+		Chapter:    a.Chapter,
+		Verse:      a.Verse,
+		EndChapter: a.EndChapter,
+		EndVerse:   a.EndVerse,
+		Color:      a.Color,
+		Note:       a.Note,
+		Tags:       a.Tags,
+		Created:    a.Created.Unix(),
+		Updated:    a.Updated.Unix(),
+		Reference:  passage.String(),
+	}
+}
+
+// covers reports whether the annotation includes a verse
+func (a *Annotation) covers(chapter, verse int32) bool {
+	after := chapter > a.Chapter || (chapter == a.Chapter && verse >= a.Verse)
+	before := chapter < a.EndChapter || (chapter == a.EndChapter && verse <= a.EndVerse)
+	return after && before
+}
+
+// annotationFromRequest validates an annotation message and converts it
+func annotationFromRequest(annotation *wordsearcher.Annotation) (*Annotation, error) {
+	if !annotationTypes[annotation.GetType()] {
+		return nil, status.Errorf(codes.InvalidArgument, "The type must be bookmark, highlight or note. Invalid: %v", annotation.GetType())
+	}
+	book, ok := wsbible.BookByNumber(annotation.GetBook())
+	if !ok {
+		return nil, status.Errorf(codes.OutOfRange, "The book must be between 1 and %d. Invalid: %v", len(wsbible.Books), annotation.GetBook())
+	}
+	a := &Annotation{
+		Type:       annotation.GetType(),
+		Book:       book.Number,
+		Chapter:    annotation.GetChapter(),
+		Verse:      annotation.GetVerse(),
+		EndChapter: annotation.GetEndChapter(),
+		EndVerse:   annotation.GetEndVerse(),
+		Note:       annotation.GetNote(),
+		Tags:       []string{},
+	}
+	if a.EndChapter == 0 {
+		a.EndChapter = a.Chapter
+	}
+	if a.EndVerse == 0 {
+		a.EndVerse = a.Verse
+	}
+	if a.Chapter < 1 || a.EndChapter > book.Chapters || a.Verse < 1 ||
+		a.EndChapter < a.Chapter || (a.EndChapter == a.Chapter && a.EndVerse < a.Verse) {
+		return nil, status.Errorf(codes.OutOfRange, "Invalid range %d:%d-%d:%d of %s", a.Chapter, a.Verse, a.EndChapter, a.EndVerse, book.Name)
+	}
+
+	switch a.Type {
+	case "highlight":
+		color := strings.ToLower(annotation.GetColor())
+		if color == "" {
+			color = "yellow"
+		}
+		if !highlightColors[color] && !hexColor.MatchString(color) {
+			return nil, status.Errorf(codes.InvalidArgument, "The color must be a name or #rrggbb. Invalid: %v", annotation.GetColor())
+		}
+		a.Color = color
+	case "note":
+		if strings.TrimSpace(a.Note) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "A note needs text")
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, tag := range annotation.GetTags() {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			a.Tags = append(a.Tags, tag)
+		}
+	}
+	sort.Strings(a.Tags)
+	return a, nil
+}
+
+func (s server) CreateAnnotation(ctx context.Context, request *wordsearcher.AnnotationRequest) (*wordsearcher.AnnotationResponse, error) {
+	// Functionality
+	// - Saves a bookmark, highlight or note of the signed in user.
+	//
+	// **Error Handling
+	// - If the type, color or note is invalid return invalid argument error
+	// - If the range is not in the book return out of range error
+
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	annotation, err := annotationFromRequest(request.GetAnnotation())
+	if err != nil {
+		return nil, err
+	}
+	annotation.UserID = userID
+	annotation.Created = time.Now()
+	annotation.Updated = annotation.Created
+
+	annotationCollection := db.Database(config.Database).Collection(config.Collections.Annotation)
+	_, err = annotationCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "book", Value: 1}, {Key: "chapter", Value: 1}},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error preparing the annotation collection: %v", err)
+	}
+	res, err := annotationCollection.InsertOne(ctx, annotation)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error saving the annotation: %v", err)
+	}
+	annotation.ID = res.InsertedID.(primitive.ObjectID)
+
+	return &wordsearcher.AnnotationResponse{
+		Annotation: annotation.proto(),
+	}, nil
+}
+
+func (s server) UpdateAnnotation(ctx context.Context, request *wordsearcher.AnnotationRequest) (*wordsearcher.AnnotationResponse, error) {
+	// Functionality
+	// - Replaces an annotation of the signed in user, keeping when it was created.
+	//
+	// **Error Handling
+	// - If the annotation is not the user's return not found error
+	// - If the type, color, note or range is invalid return invalid argument or out of range error
+
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := primitive.ObjectIDFromHex(request.GetAnnotation().GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid annotation id: %v", request.GetAnnotation().GetId())
+	}
+	annotation, err := annotationFromRequest(request.GetAnnotation())
+	if err != nil {
+		return nil, err
+	}
+
+	annotationCollection := db.Database(config.Database).Collection(config.Collections.Annotation)
+	var existing *Annotation
+	err = annotationCollection.FindOne(ctx, bson.M{"_id": id, "user_id": userID}).Decode(&existing)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "There is no annotation %s", id.Hex())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the annotation: %v", err)
+	}
+	annotation.ID = id
+	annotation.UserID = userID
+	annotation.Created = existing.Created
+	annotation.Updated = time.Now()
+	if _, err := annotationCollection.ReplaceOne(ctx, bson.M{"_id": id, "user_id": userID}, annotation); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error saving the annotation: %v", err)
+	}
+
+	return &wordsearcher.AnnotationResponse{
+		Annotation: annotation.proto(),
+	}, nil
+}
+
+func (s server) DeleteAnnotation(ctx context.Context, request *wordsearcher.DeleteAnnotationRequest) (*wordsearcher.DeleteAnnotationResponse, error) {
+	// Functionality
+	// - Deletes an annotation of the signed in user.
+	//
+	// **Error Handling
+	// - If the annotation is not the user's return not found error
+
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := primitive.ObjectIDFromHex(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid annotation id: %v", request.GetId())
+	}
+
+	annotationCollection := db.Database(config.Database).Collection(config.Collections.Annotation)
+	res, err := annotationCollection.DeleteOne(ctx, bson.M{"_id": id, "user_id": userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error deleting the annotation: %v", err)
+	}
+	if res.DeletedCount == 0 {
+		return nil, status.Errorf(codes.NotFound, "There is no annotation %s", id.Hex())
+	}
+	return &wordsearcher.DeleteAnnotationResponse{}, nil
+}
+
+func (s server) ListAnnotations(ctx context.Context, request *wordsearcher.ListAnnotationsRequest) (*wordsearcher.ListAnnotationsResponse, error) {
+	// Functionality
+	// - Lists the signed in user's annotations, most recently updated first, filtered
+	//   by type, book, tag and the dates they were updated.
+	// - Returns defaultAnnotationLimit annotations when no limit is given and at
+	//   most maxAnnotationLimit.
+
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"user_id": userID}
+	if request.GetType() != "" {
+		filter["type"] = request.GetType()
+	}
+	if request.GetBook() != 0 {
+		filter["book"] = request.GetBook()
+	}
+	if request.GetTag() != "" {
+		filter["tags"] = strings.ToLower(strings.TrimSpace(request.GetTag()))
+	}
+	updated := bson.M{}
+	if request.GetSince() != 0 {
+		updated["$gte"] = time.Unix(request.GetSince(), 0)
+	}
+	if request.GetUntil() != 0 {
+		updated["$lt"] = time.Unix(request.GetUntil(), 0)
+	}
+	if len(updated) > 0 {
+		filter["updated"] = updated
+	}
+
+	findOptions := options.Find().
+		SetSort(bson.M{"updated": -1}).
+		SetLimit(listLimit(request.GetLimit(), defaultAnnotationLimit, maxAnnotationLimit))
+	annotationCollection := db.Database(config.Database).Collection(config.Collections.Annotation)
+	var annotations []*Annotation
+	annotationCursor, err := annotationCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the annotations: %v", err)
+	}
+	if cursorErr := annotationCursor.All(ctx, &annotations); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into annotations: %v", cursorErr)
+	}
+
+	// build the protocol buffer response
+	var annotationResponses []*wordsearcher.Annotation
+	for _, annotation := range annotations {
+		annotationResponses = append(annotationResponses, annotation.proto())
+	}
+	return &wordsearcher.ListAnnotationsResponse{
+		Annotations: annotationResponses,
+	}, nil
+}
+
+// attachAnnotations sets the signed in user's annotations on the verses they cover
+func attachAnnotations(ctx context.Context, verses []*wordsearcher.Verse) error {
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return err
+	}
+	if len(verses) == 0 {
+		return nil
+	}
+
+	// find the annotations of the books in the response
+	firstBook, lastBook := verses[0].GetBook(), verses[0].GetBook()
+	for _, verse := range verses {
+		if verse.GetBook() < firstBook {
+			firstBook = verse.GetBook()
+		}
+		if verse.GetBook() > lastBook {
+			lastBook = verse.GetBook()
+		}
+	}
+	annotationCollection := db.Database(config.Database).Collection(config.Collections.Annotation)
+	filter := bson.M{
+		"user_id": userID,
+		"book": bson.M{
+			"$gte": firstBook,
+			"$lte": lastBook,
+		},
+	}
+	var annotations []*Annotation
+	annotationCursor, err := annotationCollection.Find(ctx, filter, options.Find().SetSort(bson.M{"created": 1}))
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error finding the annotations: %v", err)
+	}
+	if cursorErr := annotationCursor.All(ctx, &annotations); cursorErr != nil {
+		return status.Errorf(codes.Internal, "Error decoding the cursor into annotations: %v", cursorErr)
+	}
+
+	byBook := make(map[int32][]*Annotation)
+	for _, annotation := range annotations {
+		byBook[annotation.Book] = append(byBook[annotation.Book], annotation)
+	}
+	for _, verse := range verses {
+		for _, annotation := range byBook[verse.GetBook()] {
+			if annotation.covers(verse.GetChapter(), verse.GetVerse()) {
+				verse.Annotations = append(verse.Annotations, annotation.proto())
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListLimit(t *testing.T) {
+	tests := []struct {
+		limit int32
+		want  int64
+	}{
+		{0, defaultAnnotationLimit},
+		{-5, defaultAnnotationLimit},
+		{1, 1},
+		{maxAnnotationLimit, maxAnnotationLimit},
+		{maxAnnotationLimit + 1, maxAnnotationLimit},
+		{1 << 30, maxAnnotationLimit},
+	}
+	for _, test := range tests {
+		if got := listLimit(test.limit, defaultAnnotationLimit, maxAnnotationLimit); got != test.want {
+			t.Errorf("listLimit(%d) = %d, want %d", test.limit, got, test.want)
+		}
+	}
+}
+
+func TestAnnotationFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *wordsearcher.Annotation
+		want    *Annotation
+		code    codes.Code
+	}{
+		{
+			name:    "a bookmark ends where it starts",
+			request: &wordsearcher.Annotation{Type: "bookmark", Book: 43, Chapter: 3, Verse: 16},
+			want:    &Annotation{Type: "bookmark", Book: 43, Chapter: 3, Verse: 16, EndChapter: 3, EndVerse: 16, Tags: []string{}},
+		},
+		{
+			name:    "a highlight is yellow by default",
+			request: &wordsearcher.Annotation{Type: "highlight", Book: 19, Chapter: 23, Verse: 1, EndVerse: 6},
+			want:    &Annotation{Type: "highlight", Book: 19, Chapter: 23, Verse: 1, EndChapter: 23, EndVerse: 6, Color: "yellow", Tags: []string{}},
+		},
+		{
+			name:    "a hex color in lower case",
+			request: &wordsearcher.Annotation{Type: "highlight", Book: 1, Chapter: 1, Verse: 1, Color: "#FFAA00"},
+			want:    &Annotation{Type: "highlight", Book: 1, Chapter: 1, Verse: 1, EndChapter: 1, EndVerse: 1, Color: "#ffaa00", Tags: []string{}},
+		},
+		{
+			name:    "a note over chapters with tags tidied",
+			request: &wordsearcher.Annotation{Type: "note", Book: 45, Chapter: 7, Verse: 24, EndChapter: 8, EndVerse: 1, Note: "No condemnation", Tags: []string{" Grace", "law", "grace", ""}},
+			want:    &Annotation{Type: "note", Book: 45, Chapter: 7, Verse: 24, EndChapter: 8, EndVerse: 1, Note: "No condemnation", Tags: []string{"grace", "law"}},
+		},
+		{name: "an unknown type", request: &wordsearcher.Annotation{Type: "underline", Book: 43, Chapter: 3, Verse: 16}, code: codes.InvalidArgument},
+		{name: "no book", request: &wordsearcher.Annotation{Type: "bookmark", Chapter: 3, Verse: 16}, code: codes.OutOfRange},
+		{name: "a book past Revelation", request: &wordsearcher.Annotation{Type: "bookmark", Book: 67, Chapter: 1, Verse: 1}, code: codes.OutOfRange},
+		{name: "no chapter", request: &wordsearcher.Annotation{Type: "bookmark", Book: 43, Verse: 16}, code: codes.OutOfRange},
+		{name: "a chapter past the book", request: &wordsearcher.Annotation{Type: "bookmark", Book: 43, Chapter: 22, Verse: 1}, code: codes.OutOfRange},
+		{name: "an end before the start", request: &wordsearcher.Annotation{Type: "bookmark", Book: 43, Chapter: 3, Verse: 16, EndVerse: 15}, code: codes.OutOfRange},
+		{name: "an end chapter before the start", request: &wordsearcher.Annotation{Type: "bookmark", Book: 43, Chapter: 3, Verse: 16, EndChapter: 2, EndVerse: 20}, code: codes.OutOfRange},
+		{name: "an unknown color", request: &wordsearcher.Annotation{Type: "highlight", Book: 43, Chapter: 3, Verse: 16, Color: "red"}, code: codes.InvalidArgument},
+		{name: "a short hex color", request: &wordsearcher.Annotation{Type: "highlight", Book: 43, Chapter: 3, Verse: 16, Color: "#fa0"}, code: codes.InvalidArgument},
+		{name: "a note without text", request: &wordsearcher.Annotation{Type: "note", Book: 43, Chapter: 3, Verse: 16, Note: "  "}, code: codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := annotationFromRequest(test.request)
+			if status.Code(err) != test.code {
+				t.Fatalf("got error %v, want %v", err, test.code)
+			}
+			if test.want != nil && !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAnnotationCovers(t *testing.T) {
+	// Romans 7:24-8:1
+	annotation := &Annotation{Book: 45, Chapter: 7, Verse: 24, EndChapter: 8, EndVerse: 1}
+	tests := []struct {
+		chapter, verse int32
+		want           bool
+	}{
+		{7, 23, false},
+		{7, 24, true},
+		{7, 25, true},
+		{8, 1, true},
+		{8, 2, false},
+		{6, 30, false},
+		{9, 1, false},
+	}
+	for _, test := range tests {
+		if got := annotation.covers(test.chapter, test.verse); got != test.want {
+			t.Errorf("covers(%d:%d) = %v, want %v", test.chapter, test.verse, got, test.want)
+		}
+	}
+}
+
+func TestAnnotationProto(t *testing.T) {
+	created := time.Date(2021, time.May, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		annotation *Annotation
+		reference  string
+	}{
+		{&Annotation{Book: 43, Chapter: 3, Verse: 16, EndChapter: 3, EndVerse: 16}, "John 3:16"},
+		{&Annotation{Book: 43, Chapter: 3, Verse: 16, EndChapter: 3, EndVerse: 18}, "John 3:16-18"},
+		{&Annotation{Book: 45, Chapter: 7, Verse: 24, EndChapter: 8, EndVerse: 1}, "Romans 7:24-8:1"},
+	}
+	for _, test := range tests {
+		test.annotation.ID = primitive.NewObjectID()
+		test.annotation.Created, test.annotation.Updated = created, created.Add(time.Hour)
+		got := test.annotation.proto()
+		if got.GetReference() != test.reference {
+			t.Errorf("reference %q, want %q", got.GetReference(), test.reference)
+		}
+		if got.GetId() != test.annotation.ID.Hex() || got.GetCreated() != created.Unix() || got.GetUpdated() != created.Add(time.Hour).Unix() {
+			t.Errorf("got id %q created %d updated %d", got.GetId(), got.GetCreated(), got.GetUpdated())
+		}
+	}
+}
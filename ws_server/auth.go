@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tokenLifetime is how long a login token is valid
+const tokenLifetime = 30 * 24 * time.Hour
+
+// tokenSecret signs the login tokens, from the token_secret setting so tokens
+// survive a restart and work across servers
+var tokenSecret []byte
+
+// methodPrefix is the start of the full method name of every RPC
+const methodPrefix = "/wordsearcher.WordsearcherService/"
+
+// userMethods are the RPCs that need a signed in user, every other RPC is
+// public and runs anonymously without a token
+var userMethods = map[string]bool{
+	"Me":                true,
+	"Enroll":            true,
+	"MarkComplete":      true,
+	"Progress":          true,
+	"CatchUp":           true,
+	"CreateAnnotation":  true,
+	"UpdateAnnotation":  true,
+	"DeleteAnnotation":  true,
+	"ListAnnotations":   true,
+	"AddMemoryVerse":    true,
+	"RemoveMemoryVerse": true,
+	"MemoryDeck":        true,
+	"MemoryPrompt":      true,
+	"ReviewMemoryVerse": true,
+	"MemoryProgress":    true,
+}
+
+// adminMethods are the RPCs that need a signed in admin
+var adminMethods = map[string]bool{
+	"ImportPlan": true,
+	"ExportPlan": true,
+}
+
+// authUser is the signed in user a token carries
+type authUser struct {
+	ID       string `json:"sub"`
+	Username string `json:"name"`
+	Admin    bool   `json:"admin"` // for clients, requireAdmin checks the user collection
+	Expires  int64  `json:"exp"`
+}
+
+// authUserKey is the context key of the signed in user
+type authUserKey struct{}
+
+// initTokenSecret sets the token secret, generating one when it is empty
+func initTokenSecret(secret string) {
+	if secret != "" {
+		tokenSecret = []byte(secret)
+		return
+	}
+	log.Println("The token secret is not set, using a random secret: tokens will not survive a restart")
+	tokenSecret = make([]byte, 32)
+	if _, err := rand.Read(tokenSecret); err != nil {
+		log.Fatalf("Error generating a token secret: %v", err)
+	}
+}
+
+// signToken issues a token for the user, the base64 JSON claims and their
+// HMAC-SHA256 signature joined by a period
+func signToken(user authUser) (string, error) {
+	payload, err := json.Marshal(user)
+	if err != nil {
+		return "", err
+	}
+	claims := base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, tokenSecret)
+	mac.Write([]byte(claims))
+	return claims + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// verifyToken checks the signature and expiry of a token and returns its user
+func verifyToken(token string) (*authUser, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, status.Errorf(codes.Unauthenticated, "The token is malformed")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "The token is malformed")
+	}
+	mac := hmac.New(sha256.New, tokenSecret)
+	mac.Write([]byte(parts[0]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, status.Errorf(codes.Unauthenticated, "The token signature is invalid")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "The token is malformed")
+	}
+	var user authUser
+	if err := json.Unmarshal(payload, &user); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "The token is malformed")
+	}
+	if time.Now().Unix() > user.Expires {
+		return nil, status.Errorf(codes.Unauthenticated, "The token has expired, please log in again")
+	}
+	return &user, nil
+}
+
+// userFromContext returns the signed in user of a call, false for anonymous calls
+func userFromContext(ctx context.Context) (*authUser, bool) {
+	user, ok := ctx.Value(authUserKey{}).(*authUser)
+	return user, ok
+}
+
+// authInterceptor attaches the user of the "authorization: Bearer <token>"
+// metadata to the context and rejects calls to personal RPCs without one.
+// An invalid token is rejected on every RPC so clients notice it expired.
+func authInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := authenticate(ctx, strings.TrimPrefix(info.FullMethod, methodPrefix))
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authenticate checks the token of a call to the method
+func authenticate(ctx context.Context, method string) (context.Context, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("authorization") {
+			if strings.HasPrefix(strings.ToLower(value), "bearer ") {
+				token = strings.TrimSpace(value[len("bearer "):])
+			}
+		}
+	}
+
+	if token != "" {
+		user, err := verifyToken(token)
+		if err != nil {
+			return ctx, err
+		}
+		ctx = context.WithValue(ctx, authUserKey{}, user)
+	}
+
+	_, signedIn := userFromContext(ctx)
+	if (userMethods[method] || adminMethods[method]) && !signedIn {
+		return ctx, status.Errorf(codes.Unauthenticated, "%s needs a signed in user", method)
+	}
+	if adminMethods[method] {
+		if err := requireAdmin(ctx, method); err != nil {
+			return ctx, err
+		}
+	}
+	return ctx, nil
+}
+
+// requireAdmin checks the signed in user of a call is an admin. The user is
+// looked up rather than trusting the admin claim of the token, so revoking
+// admin access takes effect on the next call and not when the token expires.
+func requireAdmin(ctx context.Context, what string) error {
+	if _, ok := userFromContext(ctx); !ok {
+		return status.Errorf(codes.PermissionDenied, "%s is only for admins", what)
+	}
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if !user.Admin {
+		return status.Errorf(codes.PermissionDenied, "%s is only for admins", what)
+	}
+	return nil
+}
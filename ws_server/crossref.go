@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"math"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CrossReference struct for the cross reference collection, one document per
+// link from a verse to a verse or passage (imported with ws_import crossrefs)
+type CrossReference struct {
+	ID           primitive.ObjectID `bson:"id"`
+	FromBook     int32              `bson:"from_book"`
+	FromChapter  int32              `bson:"from_chapter"`
+	FromVerse    int32              `bson:"from_verse"`
+	ToBook       int32              `bson:"to_book"`
+	ToChapter    int32              `bson:"to_chapter"`
+	ToVerse      int32              `bson:"to_verse"`
+	ToEndBook    int32              `bson:"to_end_book"`
+	ToEndChapter int32              `bson:"to_end_chapter"`
+	ToEndVerse   int32              `bson:"to_end_verse"`
+	Votes        int32              `bson:"votes"`
+}
+
+func (s server) CrossReferences(ctx context.Context, request *wordsearcher.CrossReferencesRequest) (*wordsearcher.CrossReferencesResponse, error) {
+	// Functionality:
+	// - Finds the cross references from a verse, or a range of verses in a chapter, ordered by votes.
+	// - Optionally includes the text of each referenced passage using the Verse lookup.
+	//
+	// **Error handling
+	// - If verse start is less than 1 return out of range error
+	// - If verse end is set and less than verse start return out of range error
+
+	refCollection := db.Database(config.Database).Collection(config.Collections.CrossReference)
+
+	// validation - error handling
+	if request.GetVerseStart() < 1 {
+		return nil, status.Errorf(codes.OutOfRange, "The verse must be positive. Invalid: %v", request.GetVerseStart())
+	}
+	verseEnd := request.GetVerseEnd()
+	if verseEnd == 0 {
+		verseEnd = request.GetVerseStart()
+	}
+	if request.GetVerseStart() > verseEnd {
+		return nil, status.Errorf(codes.OutOfRange,
+			"The start of the verse range cannot be greater than the end. Invalid: Verse Start: %v; Verse End: %v",
+			request.GetVerseStart(), verseEnd)
+	}
+
+	// build the filter, highest votes first then in canonical order
+	filter := bson.M{
+		"from_book":    request.GetBook(),
+		"from_chapter": request.GetChapter(),
+		"from_verse": bson.M{
+			"$gte": request.GetVerseStart(),
+			"$lte": verseEnd,
+		},
+	}
+	if request.GetMinVotes() != 0 {
+		filter["votes"] = bson.M{"$gte": request.GetMinVotes()}
+	}
+	findOptions := options.Find().SetSort(bson.D{
+		{Key: "votes", Value: -1},
+		{Key: "to_book", Value: 1},
+		{Key: "to_chapter", Value: 1},
+		{Key: "to_verse", Value: 1},
+	})
+	if request.GetLimit() > 0 {
+		findOptions.SetLimit(int64(request.GetLimit()))
+	}
+
+	// do the Database call and store the results
+	var crossReferences []*CrossReference
+	refCursor, err := refCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Error finding the cross references: %v", err)
+	}
+	if cursorErr := refCursor.All(ctx, &crossReferences); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into cross references: %v", cursorErr)
+	}
+
+	// build the protocol buffer response
+	var refResponses []*wordsearcher.CrossReference
+	for _, ref := range crossReferences {
+		passage := wsbible.Reference{
+			Book:       ref.ToBook,
+			Chapter:    ref.ToChapter,
+			Verse:      ref.ToVerse,
+			EndBook:    ref.ToEndBook,
+			EndChapter: ref.ToEndChapter,
+			EndVerse:   ref.ToEndVerse,
+		}
+		refResponse := &wordsearcher.CrossReference{
+			FromBook:     ref.FromBook,
+			FromChapter:  ref.FromChapter,
+			FromVerse:    ref.FromVerse,
+			ToBook:       ref.ToBook,
+			ToChapter:    ref.ToChapter,
+			ToVerse:      ref.ToVerse,
+			ToEndBook:    ref.ToEndBook,
+			ToEndChapter: ref.ToEndChapter,
+			ToEndVerse:   ref.ToEndVerse,
+			Votes:        ref.Votes,
+			Reference:    passage.String(),
+		}
+		if request.GetIncludeText() {
+			if refResponse.Verses, err = s.passageVerses(ctx, passage, ""); err != nil {
+				return nil, err
+			}
+		}
+		refResponses = append(refResponses, refResponse)
+	}
+
+	return &wordsearcher.CrossReferencesResponse{
+		CrossReferences: refResponses,
+	}, nil
+}
+
+// passageVerses looks up the verses of a passage in a translation with the
+// Verse RPC, one chapter at a time so passages running over a chapter or book
+// still work.
+func (s server) passageVerses(ctx context.Context, passage wsbible.Reference, translation string) ([]*wordsearcher.Verse, error) {
+	var verses []*wordsearcher.Verse
+	book, chapter := passage.Book, passage.Chapter
+	for book < passage.EndBook || (book == passage.EndBook && chapter <= passage.EndChapter) {
+		req := &wordsearcher.VerseRequest{Book: book, Chapter: chapter, Translation: translation}
+		if book == passage.Book && chapter == passage.Chapter && passage.Verse > 0 {
+			req.VerseStart, req.VerseEnd = passage.Verse, math.MaxInt32
+		}
+		if book == passage.EndBook && chapter == passage.EndChapter && passage.EndVerse > 0 {
+			if req.VerseStart == 0 {
+				req.VerseStart = 1
+			}
+			req.VerseEnd = passage.EndVerse
+		}
+
+		res, err := s.Verse(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		verses = append(verses, res.GetVerses()...)
+
+		// move on to the next chapter, or the first chapter of the next book
+		chapter++
+		if b, ok := wsbible.BookByNumber(book); !ok || chapter > b.Chapters {
+			book, chapter = book+1, 1
+		}
+	}
+	return verses, nil
+}
@@ -0,0 +1,420 @@
+package main
+
+import (
+	"context"
+	"math"
+	"time"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dateLayout is the format of plan dates
+const dateLayout = "2006-01-02"
+
+// maxCatchUpDays is the most days a catch up schedule spreads the missed readings over
+const maxCatchUpDays = 90
+
+// Enrollment struct for the enrollment collection, a user reading a plan
+type Enrollment struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Plan      string             `bson:"plan"`
+	StartDate string             `bson:"start_date"`
+	Timezone  string             `bson:"timezone"`
+	Completed []Completion       `bson:"completed"`
+}
+
+// Completion is a reading of a plan day the user has read
+type Completion struct {
+	Day   int32     `bson:"day"`
+	Track int32     `bson:"track"`
+	At    time.Time `bson:"at"`
+}
+
+// planTracks returns the tracks of a plan in track order
+func planTracks(ctx context.Context, name string) ([]BiblePlan, error) {
+	planCollection := db.Database(config.Database).Collection(config.Collections.ReadingPlan)
+	var biblePlans []BiblePlan
+	planCursor, err := planCollection.Find(ctx, bson.M{"name": name}, options.Find().SetSort(bson.M{"number": 1}))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the bible plan: %v", err)
+	}
+	if cursorErr := planCursor.All(ctx, &biblePlans); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into plans: %v", cursorErr)
+	}
+	if len(biblePlans) == 0 {
+		return nil, status.Errorf(codes.NotFound, "There is no bible plan named %q", name)
+	}
+	return biblePlans, nil
+}
+
+// planLength is the number of days of the longest track
+func planLength(tracks []BiblePlan) int32 {
+	var days int32
+	for _, track := range tracks {
+		if int32(len(track.Days)) > days {
+			days = int32(len(track.Days))
+		}
+	}
+	return days
+}
+
+// planReadings returns the non blank readings of a plan day
+func planReadings(tracks []BiblePlan, day int32) []*wordsearcher.PlanReading {
+	var readings []*wordsearcher.PlanReading
+	for _, track := range tracks {
+		if day >= 1 && int(day) <= len(track.Days) && track.Days[day-1] != "" {
+			readings = append(readings, &wordsearcher.PlanReading{
+				Day:     day,
+				Track:   track.Number,
+				Reading: track.Days[day-1],
+			})
+		}
+	}
+	return readings
+}
+
+// location loads the time zone of the enrollment
+func (e *Enrollment) location() *time.Location {
+	if loc, err := time.LoadLocation(e.Timezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// today returns the plan day of now, day 1 being the start date. It is 0 or
+// less before the plan starts.
+func (e *Enrollment) today() int32 {
+	loc := e.location()
+	start, err := time.ParseInLocation(dateLayout, e.StartDate, loc)
+	if err != nil {
+		return 1
+	}
+	now := time.Now().In(loc)
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	// round as days across a daylight saving change are not 24 hours
+	return int32(math.Round(midnight.Sub(start).Hours()/24)) + 1
+}
+
+// date returns the date of a plan day
+func (e *Enrollment) date(day int32) string {
+	loc := e.location()
+	start, err := time.ParseInLocation(dateLayout, e.StartDate, loc)
+	if err != nil {
+		return ""
+	}
+	return start.AddDate(0, 0, int(day-1)).Format(dateLayout)
+}
+
+// done reports whether a reading has been completed
+func (e *Enrollment) done(day, track int32) bool {
+	for _, completion := range e.Completed {
+		if completion.Day == day && completion.Track == track {
+			return true
+		}
+	}
+	return false
+}
+
+// dayDone reports whether every reading of a day has been completed
+func (e *Enrollment) dayDone(tracks []BiblePlan, day int32) bool {
+	for _, reading := range planReadings(tracks, day) {
+		if !e.done(day, reading.GetTrack()) {
+			return false
+		}
+	}
+	return true
+}
+
+// progress computes the progress of the enrollment through the plan
+func (e *Enrollment) progress(tracks []BiblePlan) *wordsearcher.PlanProgress {
+	days := planLength(tracks)
+	progress := &wordsearcher.PlanProgress{
+		Plan:       e.Plan,
+		CurrentDay: e.today(),
+		Days:       days,
+	}
+
+	var readings, read int
+	for day := int32(1); day <= days; day++ {
+		dayReadings := planReadings(tracks, day)
+		readings += len(dayReadings)
+		complete := true
+		for _, reading := range dayReadings {
+			if e.done(day, reading.GetTrack()) {
+				read++
+			} else {
+				complete = false
+			}
+		}
+		if complete {
+			progress.CompletedDays++
+		} else if day < progress.GetCurrentDay() {
+			progress.MissedDays = append(progress.MissedDays, day)
+		}
+	}
+	if readings > 0 {
+		progress.Percent = float64(read) * 100 / float64(readings)
+	}
+	progress.Finished = progress.GetCompletedDays() == days
+
+	// the streak runs back from today, or yesterday when today is not read yet
+	day := progress.GetCurrentDay()
+	if day > days {
+		day = days
+	}
+	if !e.dayDone(tracks, day) {
+		day--
+	}
+	for ; day >= 1 && e.dayDone(tracks, day); day-- {
+		progress.Streak++
+	}
+	return progress
+}
+
+// findEnrollment loads the signed in user's enrollment in a plan
+func findEnrollment(ctx context.Context, plan string) (*Enrollment, error) {
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enrollmentCollection := db.Database(config.Database).Collection(config.Collections.Enrollment)
+	var enrollment *Enrollment
+	err = enrollmentCollection.FindOne(ctx, bson.M{"user_id": userID, "plan": plan}).Decode(&enrollment)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "You are not enrolled in the plan %q", plan)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the enrollment: %v", err)
+	}
+	return enrollment, nil
+}
+
+func (s server) Enroll(ctx context.Context, request *wordsearcher.EnrollRequest) (*wordsearcher.EnrollResponse, error) {
+	// Functionality
+	// - Enrolls the signed in user in a plan from a start date, day 1 of the plan,
+	//   in their time zone so days change at their midnight.
+	//
+	// **Error Handling
+	// - If the plan does not exist return not found error
+	// - If the date or time zone is invalid return invalid argument error
+	// - If already enrolled return already exists error, unless restarting
+
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tracks, err := planTracks(ctx, request.GetPlan())
+	if err != nil {
+		return nil, err
+	}
+	timezone := request.GetTimezone()
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown time zone: %v", timezone)
+	}
+	startDate := request.GetStartDate()
+	if startDate == "" {
+		startDate = time.Now().In(loc).Format(dateLayout)
+	}
+	if _, err := time.Parse(dateLayout, startDate); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "The start date must be YYYY-MM-DD. Invalid: %v", startDate)
+	}
+
+	enrollmentCollection := db.Database(config.Database).Collection(config.Collections.Enrollment)
+	_, err = enrollmentCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "plan", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error preparing the enrollment collection: %v", err)
+	}
+	enrollment := &Enrollment{
+		UserID:    userID,
+		Plan:      request.GetPlan(),
+		StartDate: startDate,
+		Timezone:  timezone,
+		Completed: []Completion{},
+	}
+	filter := bson.M{"user_id": userID, "plan": request.GetPlan()}
+	if request.GetRestart() {
+		_, err = enrollmentCollection.ReplaceOne(ctx, filter, enrollment, options.Replace().SetUpsert(true))
+	} else {
+		_, err = enrollmentCollection.InsertOne(ctx, enrollment)
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, status.Errorf(codes.AlreadyExists, "You are already enrolled in the plan %q, restart to start over", request.GetPlan())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error saving the enrollment: %v", err)
+	}
+
+	return &wordsearcher.EnrollResponse{
+		Enrollment: &wordsearcher.Enrollment{
+			Plan:      enrollment.Plan,
+			StartDate: enrollment.StartDate,
+			Timezone:  enrollment.Timezone,
+			Days:      planLength(tracks),
+		},
+	}, nil
+}
+
+func (s server) MarkComplete(ctx context.Context, request *wordsearcher.MarkCompleteRequest) (*wordsearcher.PlanProgressResponse, error) {
+	// Functionality
+	// - Marks a reading of a plan day, or the whole day, read (or unread with undo)
+	//   and returns the new progress.
+	//
+	// **Error Handling
+	// - If not enrolled return not found error
+	// - If the day or track is not in the plan return out of range error
+
+	enrollment, err := findEnrollment(ctx, request.GetPlan())
+	if err != nil {
+		return nil, err
+	}
+	tracks, err := planTracks(ctx, request.GetPlan())
+	if err != nil {
+		return nil, err
+	}
+	day := request.GetDay()
+	if day == 0 {
+		day = enrollment.today()
+	}
+	if day < 1 || day > planLength(tracks) {
+		return nil, status.Errorf(codes.OutOfRange, "The day must be between 1 and %d. Invalid: %v", planLength(tracks), day)
+	}
+
+	// the readings to mark, a track of the day or all of them
+	var marks []int32
+	for _, reading := range planReadings(tracks, day) {
+		if request.GetTrack() == 0 || request.GetTrack() == reading.GetTrack() {
+			marks = append(marks, reading.GetTrack())
+		}
+	}
+	if len(marks) == 0 {
+		return nil, status.Errorf(codes.OutOfRange, "Day %d has no reading %d", day, request.GetTrack())
+	}
+
+	completed := enrollment.Completed[:0]
+	for _, completion := range enrollment.Completed {
+		marked := false
+		for _, track := range marks {
+			marked = marked || (completion.Day == day && completion.Track == track)
+		}
+		if !marked {
+			completed = append(completed, completion)
+		}
+	}
+	if !request.GetUndo() {
+		for _, track := range marks {
+			completed = append(completed, Completion{Day: day, Track: track, At: time.Now()})
+		}
+	}
+	enrollment.Completed = completed
+
+	enrollmentCollection := db.Database(config.Database).Collection(config.Collections.Enrollment)
+	_, err = enrollmentCollection.UpdateOne(ctx,
+		bson.M{"_id": enrollment.ID},
+		bson.M{"$set": bson.M{"completed": enrollment.Completed}})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error saving the progress: %v", err)
+	}
+
+	return &wordsearcher.PlanProgressResponse{
+		Progress: enrollment.progress(tracks),
+	}, nil
+}
+
+func (s server) Progress(ctx context.Context, request *wordsearcher.PlanProgressRequest) (*wordsearcher.PlanProgressResponse, error) {
+	// Functionality
+	// - Returns the signed in user's current day, streak, percent complete and missed
+	//   days of a plan.
+	//
+	// **Error Handling
+	// - If not enrolled return not found error
+
+	enrollment, err := findEnrollment(ctx, request.GetPlan())
+	if err != nil {
+		return nil, err
+	}
+	tracks, err := planTracks(ctx, request.GetPlan())
+	if err != nil {
+		return nil, err
+	}
+
+	return &wordsearcher.PlanProgressResponse{
+		Progress: enrollment.progress(tracks),
+	}, nil
+}
+
+func (s server) CatchUp(ctx context.Context, request *wordsearcher.CatchUpRequest) (*wordsearcher.CatchUpResponse, error) {
+	// Functionality
+	// - Builds a schedule for the next days, from today, spreading the unread readings
+	//   of the missed days evenly over them on top of each day's own readings.
+	//
+	// **Error Handling
+	// - If not enrolled return not found error
+	// - If days is less than 1 return invalid argument error
+	// - If days is more than maxCatchUpDays return out of range error
+
+	if request.GetDays() < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "Catching up needs at least one day. Invalid: %v", request.GetDays())
+	}
+	if request.GetDays() > maxCatchUpDays {
+		return nil, status.Errorf(codes.OutOfRange, "Catching up can take at most %d days. Invalid: %v", maxCatchUpDays, request.GetDays())
+	}
+	enrollment, err := findEnrollment(ctx, request.GetPlan())
+	if err != nil {
+		return nil, err
+	}
+	tracks, err := planTracks(ctx, request.GetPlan())
+	if err != nil {
+		return nil, err
+	}
+
+	return &wordsearcher.CatchUpResponse{
+		Days: enrollment.catchUp(tracks, int(request.GetDays())),
+	}, nil
+}
+
+// catchUp schedules the next n days from today, each with its own unread
+// readings and an even share of the unread readings of the missed days
+func (e *Enrollment) catchUp(tracks []BiblePlan, n int) []*wordsearcher.CatchUpDay {
+	// the unread readings of the days before today
+	today := e.today()
+	var missed []*wordsearcher.PlanReading
+	for day := int32(1); day < today && day <= planLength(tracks); day++ {
+		for _, reading := range planReadings(tracks, day) {
+			if !e.done(day, reading.GetTrack()) {
+				missed = append(missed, reading)
+			}
+		}
+	}
+
+	// the days from today with their own unread readings and a share of the missed ones
+	var catchUpDays []*wordsearcher.CatchUpDay
+	for i := 0; i < n; i++ {
+		day := today + int32(i)
+		catchUpDay := &wordsearcher.CatchUpDay{
+			Date: e.date(day),
+			Day:  day,
+		}
+		for _, reading := range planReadings(tracks, day) {
+			if !e.done(day, reading.GetTrack()) {
+				catchUpDay.Readings = append(catchUpDay.Readings, reading)
+			}
+		}
+		catchUpDay.Readings = append(catchUpDay.Readings, missed[i*len(missed)/n:(i+1)*len(missed)/n]...)
+		catchUpDays = append(catchUpDays, catchUpDay)
+	}
+	return catchUpDays
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testTracks is a five day plan, the second track is shorter and rests on day 2
+var testTracks = []BiblePlan{
+	{Name: "Test", Number: 1, Days: []string{"Gen 1", "Gen 2", "Gen 3", "Gen 4", "Gen 5"}},
+	{Name: "Test", Number: 2, Days: []string{"Matt 1", "", "Matt 2", "Matt 3"}},
+}
+
+// startedDaysAgo is an enrollment in the test plan started days ago in UTC
+func startedDaysAgo(days int, completed ...Completion) *Enrollment {
+	return &Enrollment{
+		Plan:      "Test",
+		StartDate: time.Now().UTC().AddDate(0, 0, -days).Format(dateLayout),
+		Timezone:  "UTC",
+		Completed: completed,
+	}
+}
+
+// readings formats plan readings as day/track reading
+func readings(planReadings []*wordsearcher.PlanReading) []string {
+	var formatted []string
+	for _, reading := range planReadings {
+		formatted = append(formatted, fmt.Sprintf("%d/%d %s", reading.GetDay(), reading.GetTrack(), reading.GetReading()))
+	}
+	return formatted
+}
+
+func TestPlanLength(t *testing.T) {
+	if got := planLength(testTracks); got != 5 {
+		t.Errorf("planLength = %d, want the longest track, 5", got)
+	}
+	if got := planLength(nil); got != 0 {
+		t.Errorf("planLength(nil) = %d, want 0", got)
+	}
+}
+
+func TestPlanReadings(t *testing.T) {
+	tests := []struct {
+		day  int32
+		want []string
+	}{
+		{1, []string{"1/1 Gen 1", "1/2 Matt 1"}},
+		{2, []string{"2/1 Gen 2"}}, // a rest day of the second track
+		{5, []string{"5/1 Gen 5"}}, // past the end of the second track
+		{0, nil},
+		{6, nil},
+	}
+	for _, test := range tests {
+		if got := readings(planReadings(testTracks, test.day)); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("planReadings(%d) = %q, want %q", test.day, got, test.want)
+		}
+	}
+}
+
+func TestEnrollmentToday(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		name       string
+		enrollment *Enrollment
+		want       int32
+	}{
+		{"starting today", startedDaysAgo(0), 1},
+		{"started days ago", startedDaysAgo(4), 5},
+		{"starting tomorrow", startedDaysAgo(-1), 0},
+		{"invalid start date", &Enrollment{StartDate: "tomorrow"}, 1},
+		{"across a time zone", &Enrollment{StartDate: now.In(mustLocation(t, "Pacific/Kiritimati")).Format(dateLayout), Timezone: "Pacific/Kiritimati"}, 1},
+		{"unknown time zone", &Enrollment{StartDate: now.AddDate(0, 0, -1).Format(dateLayout), Timezone: "Mars/Olympus"}, 2},
+	}
+	for _, test := range tests {
+		if got := test.enrollment.today(); got != test.want {
+			t.Errorf("%s: today() = %d, want %d", test.name, got, test.want)
+		}
+	}
+
+	enrollment := startedDaysAgo(4)
+	if got := enrollment.date(1); got != enrollment.StartDate {
+		t.Errorf("date(1) = %q, want the start date %q", got, enrollment.StartDate)
+	}
+	if got, want := enrollment.date(5), now.Format(dateLayout); got != want {
+		t.Errorf("date(5) = %q, want today %q", got, want)
+	}
+}
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("no time zone data: %v", err)
+	}
+	return loc
+}
+
+func TestEnrollmentProgress(t *testing.T) {
+	read := func(day, track int32) Completion { return Completion{Day: day, Track: track} }
+	tests := []struct {
+		name       string
+		enrollment *Enrollment
+		want       *wordsearcher.PlanProgress
+	}{
+		{
+			name:       "nothing read on day 4",
+			enrollment: startedDaysAgo(3),
+			want:       &wordsearcher.PlanProgress{Plan: "Test", CurrentDay: 4, Days: 5, MissedDays: []int32{1, 2, 3}},
+		},
+		{
+			name:       "read up to yesterday",
+			enrollment: startedDaysAgo(3, read(1, 1), read(1, 2), read(2, 1), read(3, 1), read(3, 2)),
+			want:       &wordsearcher.PlanProgress{Plan: "Test", CurrentDay: 4, Days: 5, CompletedDays: 3, Percent: 62.5, Streak: 3},
+		},
+		{
+			name:       "a day missed, today read",
+			enrollment: startedDaysAgo(3, read(1, 1), read(1, 2), read(3, 1), read(3, 2), read(4, 1), read(4, 2)),
+			want:       &wordsearcher.PlanProgress{Plan: "Test", CurrentDay: 4, Days: 5, CompletedDays: 3, Percent: 75, Streak: 2, MissedDays: []int32{2}},
+		},
+		{
+			name:       "half a day read",
+			enrollment: startedDaysAgo(0, read(1, 1)),
+			want:       &wordsearcher.PlanProgress{Plan: "Test", CurrentDay: 1, Days: 5, Percent: 12.5},
+		},
+		{
+			name: "finished after the end",
+			enrollment: startedDaysAgo(9, read(1, 1), read(1, 2), read(2, 1), read(3, 1), read(3, 2),
+				read(4, 1), read(4, 2), read(5, 1)),
+			want: &wordsearcher.PlanProgress{Plan: "Test", CurrentDay: 10, Days: 5, CompletedDays: 5, Percent: 100, Streak: 5, Finished: true},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.enrollment.progress(testTracks); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentCatchUp(t *testing.T) {
+	read := func(day, track int32) Completion { return Completion{Day: day, Track: track} }
+	tests := []struct {
+		name       string
+		enrollment *Enrollment
+		days       int
+		want       [][]string
+	}{
+		{
+			name:       "missed readings spread over two days",
+			enrollment: startedDaysAgo(3),
+			days:       2,
+			want: [][]string{
+				{"4/1 Gen 4", "4/2 Matt 3", "1/1 Gen 1", "1/2 Matt 1"},
+				{"5/1 Gen 5", "2/1 Gen 2", "3/1 Gen 3", "3/2 Matt 2"},
+			},
+		},
+		{
+			name:       "read readings are left out",
+			enrollment: startedDaysAgo(3, read(1, 1), read(1, 2), read(3, 2), read(4, 2)),
+			days:       1,
+			want:       [][]string{{"4/1 Gen 4", "2/1 Gen 2", "3/1 Gen 3"}},
+		},
+		{
+			name:       "past the end of the plan",
+			enrollment: startedDaysAgo(4, read(1, 1), read(1, 2), read(2, 1), read(3, 1)),
+			days:       3,
+			want:       [][]string{{"5/1 Gen 5", "3/2 Matt 2"}, {"4/1 Gen 4"}, {"4/2 Matt 3"}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			days := test.enrollment.catchUp(testTracks, test.days)
+			var got [][]string
+			for i, day := range days {
+				if want := test.enrollment.date(day.GetDay()); day.GetDate() != want || day.GetDay() != test.enrollment.today()+int32(i) {
+					t.Errorf("day %d is plan day %d on %s, want %s", i, day.GetDay(), day.GetDate(), want)
+				}
+				got = append(got, readings(day.GetReadings()))
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCatchUpDays(t *testing.T) {
+	tests := []struct {
+		days int32
+		code codes.Code
+	}{
+		{0, codes.InvalidArgument},
+		{-1, codes.InvalidArgument},
+		{maxCatchUpDays + 1, codes.OutOfRange},
+		{1 << 30, codes.OutOfRange},
+	}
+	for _, test := range tests {
+		_, err := server{}.CatchUp(context.Background(), &wordsearcher.CatchUpRequest{Plan: "Test", Days: test.days})
+		if status.Code(err) != test.code {
+			t.Errorf("CatchUp(%d days) = %v, want %v", test.days, err, test.code)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Footnote struct for the footnote collection, a note of a translation anchored
+// at a code point offset into the verse text (imported with ws_import footnotes)
+type Footnote struct {
+	ID          primitive.ObjectID `bson:"id"`
+	Translation string             `bson:"translation"`
+	Book        int32              `bson:"book"`
+	Chapter     int32              `bson:"chapter"`
+	Verse       int32              `bson:"verse"`
+	Offset      int32              `bson:"offset"`
+	Type        string             `bson:"type"`
+	Text        string             `bson:"text"`
+}
+
+// proto converts the footnote into its protocol buffer message
+func (f *Footnote) proto() *wordsearcher.Footnote {
+	return &wordsearcher.Footnote{
+		Offset: f.Offset,
+		Type:   f.Type,
+		Text:   f.Text,
+	}
+}
+
+// attachFootnotes sets the footnotes of the translation on the verses
+func attachFootnotes(ctx context.Context, translation string, verses []*wordsearcher.Verse) error {
+	if len(verses) == 0 {
+		return nil
+	}
+
+	// find the footnotes of the books in the response
+	firstBook, lastBook := verses[0].GetBook(), verses[0].GetBook()
+	for _, verse := range verses {
+		if verse.GetBook() < firstBook {
+			firstBook = verse.GetBook()
+		}
+		if verse.GetBook() > lastBook {
+			lastBook = verse.GetBook()
+		}
+	}
+	footnoteCollection := db.Database(config.Database).Collection(config.Collections.Footnote)
+	filter := bson.M{
+		"translation": translationFilter(translation),
+		"book": bson.M{
+			"$gte": firstBook,
+			"$lte": lastBook,
+		},
+	}
+	var footnotes []*Footnote
+	footnoteCursor, err := footnoteCollection.Find(ctx, filter, options.Find().SetSort(bson.M{"offset": 1}))
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error finding the footnotes: %v", err)
+	}
+	if cursorErr := footnoteCursor.All(ctx, &footnotes); cursorErr != nil {
+		return status.Errorf(codes.Internal, "Error decoding the cursor into footnotes: %v", cursorErr)
+	}
+
+	notes := make(map[[3]int32][]*wordsearcher.Footnote)
+	for _, footnote := range footnotes {
+		key := [3]int32{footnote.Book, footnote.Chapter, footnote.Verse}
+		notes[key] = append(notes[key], footnote.proto())
+	}
+	for _, verse := range verses {
+		verse.Footnotes = notes[[3]int32{verse.GetBook(), verse.GetChapter(), verse.GetVerse()}]
+	}
+	return nil
+}
+
+// searchFootnotes searches the footnote layer instead of the verse text and
+// returns the verses of the matching notes, best match first, each carrying
+// only the footnotes that matched
+func searchFootnotes(ctx context.Context, request *wordsearcher.SearchRequest) (*wordsearcher.VerseResponse, error) {
+	footnoteCollection := db.Database(config.Database).Collection(config.Collections.Footnote)
+
+	// same filters as the verse text search, exact phrase or any terms in a location
+	matchDoc := bson.D{{Key: "text", Value: bson.M{"path": "text", "query": request.GetTerm()}}}
+	if request.GetFilter() == "exact" {
+		matchDoc = bson.D{{Key: "phrase", Value: bson.M{"path": "text", "query": request.GetTerm()}}}
+	}
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$search", Value: bson.M{
+			"compound": bson.M{
+				"must": bson.A{matchDoc, locationClause(request.GetLocation())},
+			},
+		}}},
+		bson.D{{Key: "$match", Value: bson.M{"translation": translationFilter(request.GetTranslation())}}},
+	}
+	footnoteCursor, err := footnoteCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Could not complete the footnote search: %v", err)
+	}
+	var footnotes []*Footnote
+	if cursorErr := footnoteCursor.All(ctx, &footnotes); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into footnotes: %v", cursorErr)
+	}
+	if len(footnotes) == 0 {
+		return &wordsearcher.VerseResponse{}, nil
+	}
+
+	// group the notes by verse, keeping the search order
+	var order [][3]int32
+	notes := make(map[[3]int32][]*wordsearcher.Footnote)
+	var verseFilters bson.A
+	for _, footnote := range footnotes {
+		key := [3]int32{footnote.Book, footnote.Chapter, footnote.Verse}
+		if _, ok := notes[key]; !ok {
+			order = append(order, key)
+			verseFilters = append(verseFilters, bson.M{
+				"book":    footnote.Book,
+				"chapter": footnote.Chapter,
+				"verse":   footnote.Verse,
+			})
+		}
+		notes[key] = append(notes[key], footnote.proto())
+	}
+
+	// look up the verses the notes belong to
+	verseCollection := db.Database(config.Database).Collection(config.Collections.Verse)
+	verseFilter := bson.M{"$or": verseFilters, "translation": translationFilter(request.GetTranslation())}
+	var verses []*Verse
+	verseCursor, err := verseCollection.Find(ctx, verseFilter)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Error finding the verses of the footnotes: %v", err)
+	}
+	if cursorErr := verseCursor.All(ctx, &verses); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into verses: %v", cursorErr)
+	}
+	byReference := make(map[[3]int32]*Verse)
+	for _, verse := range verses {
+		byReference[[3]int32{verse.Book, verse.Chapter, verse.Verse}] = verse
+	}
+
+	// build the protocol buffer response
+	var verseResponses []*wordsearcher.Verse
+	for _, key := range order {
+		verse, ok := byReference[key]
+		if !ok {
+			continue
+		}
+		verseResponse := verse.proto()
+		verseResponse.Footnotes = notes[key]
+		verseResponses = append(verseResponses, verseResponse)
+	}
+
+	return &wordsearcher.VerseResponse{
+		Verses: verseResponses,
+	}, nil
+}
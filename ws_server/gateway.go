@@ -0,0 +1,386 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// restRoute maps an HTTP method and path to an RPC. Path parameters like
+// {book} and the query parameters name request fields (proto or JSON names,
+// dotted for nested messages), aliases gives shorter query names. A body of
+// "*" is the whole request as JSON, any other body names the field it fills.
+type restRoute struct {
+	method  string
+	pattern string
+	rpc     string
+	body    string
+	aliases map[string]string
+}
+
+// restRoutes exposes every RPC of WordsearcherService. Routes are matched in
+// order, so literal paths come before the parameters they would match.
+var restRoutes = []restRoute{
+	{"GET", "/v1/verses/{book}/{chapter}", "Verse", "", map[string]string{"start": "verse_start", "end": "verse_end"}},
+	{"GET", "/v1/search", "Search", "", map[string]string{"q": "term"}},
+
+	{"POST", "/v1/plans/generate", "GeneratePlan", "*", nil},
+	{"GET", "/v1/plans/{name}", "BiblePlan", "", nil},
+	{"GET", "/v1/plans/{name}/days/{day}", "BiblePlanDay", "", nil},
+	{"POST", "/v1/plans/{name}/import", "ImportPlan", "*", nil},
+	{"GET", "/v1/plans/{name}/export", "ExportPlan", "", nil},
+
+	{"GET", "/v1/books", "BookRange", "", nil},
+	{"GET", "/v1/books/{book}/chapters", "ChapterRange", "", nil},
+	{"GET", "/v1/custom-ranges/{name}", "CustomRange", "", nil},
+
+	{"GET", "/v1/cross-references/{book}/{chapter}/{verse_start}", "CrossReferences", "", map[string]string{"end": "verse_end"}},
+	{"GET", "/v1/lexicon/{strongs}", "Lexicon", "", nil},
+	{"GET", "/v1/interlinear/{book}/{chapter}", "Interlinear", "", map[string]string{"start": "verse_start", "end": "verse_end"}},
+	{"GET", "/v1/pericopes/{book}", "Pericopes", "", nil},
+
+	{"POST", "/v1/users", "Register", "*", nil},
+	{"POST", "/v1/login", "Login", "*", nil},
+	{"GET", "/v1/me", "Me", "", nil},
+
+	{"POST", "/v1/enrollments", "Enroll", "*", nil},
+	{"POST", "/v1/enrollments/{plan}/complete", "MarkComplete", "*", nil},
+	{"GET", "/v1/enrollments/{plan}/progress", "Progress", "", nil},
+	{"GET", "/v1/enrollments/{plan}/catch-up", "CatchUp", "", nil},
+
+	{"GET", "/v1/annotations", "ListAnnotations", "", nil},
+	{"POST", "/v1/annotations", "CreateAnnotation", "annotation", nil},
+	{"PUT", "/v1/annotations/{annotation.id}", "UpdateAnnotation", "annotation", nil},
+	{"DELETE", "/v1/annotations/{id}", "DeleteAnnotation", "", nil},
+
+	{"GET", "/v1/verse-of-the-day", "VerseOfTheDay", "", nil},
+
+	{"GET", "/v1/memory", "MemoryDeck", "", nil},
+	{"POST", "/v1/memory", "AddMemoryVerse", "*", nil},
+	{"GET", "/v1/memory/progress", "MemoryProgress", "", nil},
+	{"DELETE", "/v1/memory/{id}", "RemoveMemoryVerse", "", nil},
+	{"GET", "/v1/memory/{id}/prompt", "MemoryPrompt", "", nil},
+	{"POST", "/v1/memory/{id}/review", "ReviewMemoryVerse", "*", nil},
+
+	{"GET", "/v1/puzzles/word-search", "WordSearchPuzzle", "", nil},
+	{"GET", "/v1/quiz", "Quiz", "", nil},
+	{"POST", "/v1/quiz/check", "CheckQuiz", "*", nil},
+}
+
+// gateway serves the RPCs as REST/JSON endpoints. Calls go through the same
+// generated handlers and auth interceptor as gRPC, so both behave the same.
+type gateway struct {
+	server   wordsearcher.WordsearcherServiceServer
+	handlers map[string]grpc.MethodDesc
+	inputs   map[string]protoreflect.MessageType
+}
+
+// newGateway builds the gateway of a server, warning about RPCs without a route
+func newGateway(srv wordsearcher.WordsearcherServiceServer) (*gateway, error) {
+	g := &gateway{
+		server:   srv,
+		handlers: make(map[string]grpc.MethodDesc),
+		inputs:   make(map[string]protoreflect.MessageType),
+	}
+	service := wordsearcher.File_wspb_ws_proto.Services().ByName("WordsearcherService")
+	for _, desc := range wordsearcher.WordsearcherService_ServiceDesc.Methods {
+		method := service.Methods().ByName(protoreflect.Name(desc.MethodName))
+		if method == nil {
+			return nil, fmt.Errorf("no descriptor for the %s RPC", desc.MethodName)
+		}
+		input, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+		if err != nil {
+			return nil, err
+		}
+		g.handlers[desc.MethodName] = desc
+		g.inputs[desc.MethodName] = input
+	}
+
+	routed := make(map[string]bool)
+	for _, route := range restRoutes {
+		if _, ok := g.handlers[route.rpc]; !ok {
+			return nil, fmt.Errorf("the route %s %s names an unknown RPC %s", route.method, route.pattern, route.rpc)
+		}
+		routed[route.rpc] = true
+	}
+	for name := range g.handlers {
+		if !routed[name] {
+			log.Printf("The %s RPC has no REST route", name)
+		}
+	}
+	return g, nil
+}
+
+// ServeHTTP finds the route of a request, binds it to the RPC request
+// message, calls the RPC and writes the response or error as JSON
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, params, found := matchRoute(r.Method, r.URL.Path)
+	if route == nil {
+		if found {
+			writeError(w, status.Errorf(codes.Unimplemented, "%s is not allowed on %s", r.Method, r.URL.Path), http.StatusMethodNotAllowed)
+			return
+		}
+		writeError(w, status.Errorf(codes.NotFound, "There is no endpoint %s", r.URL.Path), 0)
+		return
+	}
+
+	request := g.inputs[route.rpc].New()
+	if err := bindRequest(request, route, params, r); err != nil {
+		writeError(w, status.Errorf(codes.InvalidArgument, "%v", err), 0)
+		return
+	}
+
+	// the bearer token reaches the auth interceptor as gRPC metadata
+	ctx := r.Context()
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+	}
+	response, err := g.call(ctx, route.rpc, request.Interface())
+	if err != nil {
+		writeError(w, err, 0)
+		return
+	}
+	body, err := protojson.Marshal(response.(proto.Message))
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "Error encoding the response: %v", err), 0)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// call runs an RPC through its generated handler and the interceptors of
+// the gRPC server
+func (g *gateway) call(ctx context.Context, rpc string, request proto.Message) (interface{}, error) {
+	ctx, cancel := withRequestTimeout(ctx)
+	defer cancel()
+	decode := func(in interface{}) error {
+		proto.Merge(in.(proto.Message), request)
+		return nil
+	}
+	return g.handlers[rpc].Handler(g.server, ctx, decode, authInterceptor)
+}
+
+// matchRoute finds the route of a method and path and its path parameters.
+// found reports a path that matched with another method.
+func matchRoute(method, path string) (*restRoute, map[string]string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	found := false
+	for i := range restRoutes {
+		route := &restRoutes[i]
+		patternSegments := strings.Split(strings.Trim(route.pattern, "/"), "/")
+		if len(patternSegments) != len(segments) {
+			continue
+		}
+		params := make(map[string]string)
+		matched := true
+		for j, segment := range patternSegments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				params[segment[1:len(segment)-1]] = segments[j]
+			} else if segment != segments[j] {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		if route.method == method {
+			return route, params, true
+		}
+		found = true
+	}
+	return nil, nil, found
+}
+
+// bindRequest fills the request message from the body, query and path of an
+// HTTP request, in that order so the path wins
+func bindRequest(request protoreflect.Message, route *restRoute, params map[string]string, r *http.Request) error {
+	if route.body != "" {
+		data, err := ioutil.ReadAll(io.LimitReader(r.Body, int64(config.MaxRecvMsgSize)+1))
+		if err != nil {
+			return fmt.Errorf("error reading the body: %v", err)
+		}
+		if len(data) > config.MaxRecvMsgSize {
+			return fmt.Errorf("the body is larger than %d bytes", config.MaxRecvMsgSize)
+		}
+		if len(strings.TrimSpace(string(data))) > 0 {
+			target := request
+			if route.body != "*" {
+				field, err := messageField(request, route.body)
+				if err != nil {
+					return err
+				}
+				target = request.Mutable(field).Message()
+			}
+			if err := protojson.Unmarshal(data, target.Interface()); err != nil {
+				return fmt.Errorf("invalid JSON body: %v", err)
+			}
+		}
+	}
+
+	for name, values := range r.URL.Query() {
+		if field, ok := route.aliases[name]; ok {
+			name = field
+		}
+		if err := setField(request, name, values); err != nil {
+			return fmt.Errorf("query parameter %s: %v", name, err)
+		}
+	}
+	for name, value := range params {
+		if err := setField(request, name, []string{value}); err != nil {
+			return fmt.Errorf("path parameter %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// findField looks up a field of a message by its proto or JSON name
+func findField(message protoreflect.Message, name string) protoreflect.FieldDescriptor {
+	fields := message.Descriptor().Fields()
+	if field := fields.ByName(protoreflect.Name(name)); field != nil {
+		return field
+	}
+	return fields.ByJSONName(name)
+}
+
+// messageField looks up a singular message field
+func messageField(message protoreflect.Message, name string) (protoreflect.FieldDescriptor, error) {
+	field := findField(message, name)
+	if field == nil || field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+		return nil, fmt.Errorf("%s is not a message field of %s", name, message.Descriptor().Name())
+	}
+	return field, nil
+}
+
+// setField sets a scalar field, or appends to a repeated one, from its text.
+// The path is dotted for fields of nested messages, i.e. quiz.seed.
+func setField(message protoreflect.Message, path string, values []string) error {
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		field, err := messageField(message, name)
+		if err != nil {
+			return err
+		}
+		message = message.Mutable(field).Message()
+	}
+
+	field := findField(message, names[len(names)-1])
+	if field == nil || field.IsMap() || field.Kind() == protoreflect.MessageKind {
+		return fmt.Errorf("unknown field")
+	}
+	if field.IsList() {
+		list := message.Mutable(field).List()
+		for _, value := range values {
+			for _, item := range strings.Split(value, ",") {
+				v, err := parseScalar(field, item)
+				if err != nil {
+					return err
+				}
+				list.Append(v)
+			}
+		}
+		return nil
+	}
+	v, err := parseScalar(field, values[len(values)-1])
+	if err != nil {
+		return err
+	}
+	message.Set(field, v)
+	return nil
+}
+
+// parseScalar converts text to the value of a scalar field
+func parseScalar(field protoreflect.FieldDescriptor, text string) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(text), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(text)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(text, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(text, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(text, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(text, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(text, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(text, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.BytesKind:
+		b, err := base64.StdEncoding.DecodeString(text)
+		if err != nil {
+			b, err = base64.URLEncoding.DecodeString(text)
+		}
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.EnumKind:
+		if value := field.Enum().Values().ByName(protoreflect.Name(text)); value != nil {
+			return protoreflect.ValueOfEnum(value.Number()), nil
+		}
+		n, err := strconv.ParseInt(text, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), err
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported field type %v", field.Kind())
+}
+
+// httpStatus is the HTTP status of each gRPC code, as grpc-gateway maps them
+var httpStatus = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// writeError writes an error as a google.rpc.Status JSON object, with the
+// HTTP status of its code unless one is given
+func writeError(w http.ResponseWriter, err error, httpCode int) {
+	st := status.Convert(err)
+	if httpCode == 0 {
+		httpCode = httpStatus[st.Code()]
+	}
+	body, marshalErr := protojson.Marshal(st.Proto())
+	if marshalErr != nil {
+		body = []byte(`{"code":13,"message":"Error encoding the error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpCode)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jwjones2/wordsearcher-server/wsconfig"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/typepb"
+)
+
+// fakeServer records the request and signed in user of the RPCs the gateway
+// tests call, answering with err
+type fakeServer struct {
+	wordsearcher.UnimplementedWordsearcherServiceServer
+	err     error
+	request proto.Message
+	user    *authUser
+}
+
+func (f *fakeServer) record(ctx context.Context, request proto.Message) error {
+	f.request = request
+	f.user, _ = userFromContext(ctx)
+	return f.err
+}
+
+func (f *fakeServer) Verse(ctx context.Context, request *wordsearcher.VerseRequest) (*wordsearcher.VerseResponse, error) {
+	return &wordsearcher.VerseResponse{}, f.record(ctx, request)
+}
+
+func (f *fakeServer) Quiz(ctx context.Context, request *wordsearcher.QuizRequest) (*wordsearcher.QuizResponse, error) {
+	return &wordsearcher.QuizResponse{}, f.record(ctx, request)
+}
+
+func (f *fakeServer) CheckQuiz(ctx context.Context, request *wordsearcher.CheckQuizRequest) (*wordsearcher.CheckQuizResponse, error) {
+	return &wordsearcher.CheckQuizResponse{}, f.record(ctx, request)
+}
+
+func (f *fakeServer) Me(ctx context.Context, request *wordsearcher.MeRequest) (*wordsearcher.UserResponse, error) {
+	return &wordsearcher.UserResponse{}, f.record(ctx, request)
+}
+
+func (f *fakeServer) UpdateAnnotation(ctx context.Context, request *wordsearcher.AnnotationRequest) (*wordsearcher.AnnotationResponse, error) {
+	return &wordsearcher.AnnotationResponse{}, f.record(ctx, request)
+}
+
+func (f *fakeServer) ImportPlan(ctx context.Context, request *wordsearcher.ImportPlanRequest) (*wordsearcher.ImportPlanResponse, error) {
+	return &wordsearcher.ImportPlanResponse{}, f.record(ctx, request)
+}
+
+// newTestGateway is a gateway over a fake server with the default settings
+func newTestGateway(t *testing.T) (*gateway, *fakeServer) {
+	t.Helper()
+	config = wsconfig.Default()
+	initTokenSecret("gateway test secret")
+	fake := &fakeServer{}
+	g, err := newGateway(fake)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g, fake
+}
+
+// serve sends a request to the gateway with an optional bearer token
+func serve(g *gateway, method, target, body, token string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	return w
+}
+
+// testToken signs a token for a user that is not an admin
+func testToken(t *testing.T) string {
+	t.Helper()
+	token, err := signToken(authUser{ID: "5f1d7f3e9d1e8a2b3c4d5e6f", Username: "tester", Expires: time.Now().Add(time.Hour).Unix()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestGatewayBinding(t *testing.T) {
+	g, fake := newTestGateway(t)
+	token := testToken(t)
+	tests := []struct {
+		name                 string
+		method, target, body string
+		want                 proto.Message
+	}{
+		{
+			name:   "path and query parameters with aliases",
+			method: "GET", target: "/v1/verses/43/3?start=16&end=17&translation=kjv&include_footnotes=true",
+			want: &wordsearcher.VerseRequest{Book: 43, Chapter: 3, VerseStart: 16, VerseEnd: 17, Translation: "kjv", IncludeFootnotes: true},
+		},
+		{
+			name:   "the path wins over the query",
+			method: "GET", target: "/v1/verses/43/3?book=1&chapter=2",
+			want: &wordsearcher.VerseRequest{Book: 43, Chapter: 3},
+		},
+		{
+			name:   "JSON names in the query",
+			method: "GET", target: "/v1/verses/43/3?verseStart=16&includeFootnotes=1",
+			want: &wordsearcher.VerseRequest{Book: 43, Chapter: 3, VerseStart: 16, IncludeFootnotes: true},
+		},
+		{
+			name:   "repeated fields, comma separated or repeated",
+			method: "GET", target: "/v1/quiz?books=1,2&books=3&types=which_book&seed=9007199254740993",
+			want: &wordsearcher.QuizRequest{Books: []int32{1, 2, 3}, Types: []string{"which_book"}, Seed: 9007199254740993},
+		},
+		{
+			name:   "the query adds to the body",
+			method: "POST", target: "/v1/quiz/check?quiz.questions=5&answers=b,c",
+			body: `{"quiz": {"books": [1, 2], "seed": "7"}, "answers": ["a"]}`,
+			want: &wordsearcher.CheckQuizRequest{
+				Quiz:    &wordsearcher.QuizRequest{Books: []int32{1, 2}, Seed: 7, Questions: 5},
+				Answers: []string{"a", "b", "c"},
+			},
+		},
+		{
+			name:   "a body field and a dotted path parameter",
+			method: "PUT", target: "/v1/annotations/abc123",
+			body: `{"id": "other", "type": "note", "book": 43, "tags": ["love"]}`,
+			want: &wordsearcher.AnnotationRequest{Annotation: &wordsearcher.Annotation{Id: "abc123", Type: "note", Book: 43, Tags: []string{"love"}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake.request = nil
+			w := serve(g, test.method, test.target, test.body, token)
+			if w.Code != http.StatusOK {
+				t.Fatalf("status %d: %s", w.Code, w.Body)
+			}
+			if !proto.Equal(fake.request, test.want) {
+				t.Errorf("got request %v, want %v", fake.request, test.want)
+			}
+		})
+	}
+}
+
+func TestGatewayBadRequests(t *testing.T) {
+	g, fake := newTestGateway(t)
+	tests := []struct {
+		name                 string
+		method, target, body string
+		status               int
+	}{
+		{"a path parameter that is not a number", "GET", "/v1/verses/john/3", "", http.StatusBadRequest},
+		{"a query parameter that is not a number", "GET", "/v1/quiz?books=1,x", "", http.StatusBadRequest},
+		{"a number too large for int32", "GET", "/v1/verses/43/3?start=4294967296", "", http.StatusBadRequest},
+		{"a bool that is not one", "GET", "/v1/verses/43/3?include_footnotes=maybe", "", http.StatusBadRequest},
+		{"an unknown query parameter", "GET", "/v1/verses/43/3?chapters=4", "", http.StatusBadRequest},
+		{"a message in the query", "POST", "/v1/quiz/check?quiz=1", "", http.StatusBadRequest},
+		{"invalid JSON", "POST", "/v1/quiz/check", `{"quiz": `, http.StatusBadRequest},
+		{"an unknown JSON field", "POST", "/v1/quiz/check", `{"score": 1}`, http.StatusBadRequest},
+		{"an unknown path", "GET", "/v1/psalms", "", http.StatusNotFound},
+		{"the wrong method", "DELETE", "/v1/verses/43/3", "", http.StatusMethodNotAllowed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake.request = nil
+			w := serve(g, test.method, test.target, test.body, "")
+			if w.Code != test.status {
+				t.Errorf("status %d, want %d: %s", w.Code, test.status, w.Body)
+			}
+			if fake.request != nil {
+				t.Errorf("the RPC was called with %v", fake.request)
+			}
+		})
+	}
+}
+
+func TestGatewayErrorStatus(t *testing.T) {
+	g, fake := newTestGateway(t)
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{status.Error(codes.InvalidArgument, "bad"), http.StatusBadRequest},
+		{status.Error(codes.OutOfRange, "far"), http.StatusBadRequest},
+		{status.Error(codes.NotFound, "missing"), http.StatusNotFound},
+		{status.Error(codes.AlreadyExists, "again"), http.StatusConflict},
+		{status.Error(codes.PermissionDenied, "no"), http.StatusForbidden},
+		{status.Error(codes.Unauthenticated, "who"), http.StatusUnauthorized},
+		{status.Error(codes.Unavailable, "down"), http.StatusServiceUnavailable},
+		{status.Error(codes.Internal, "oops"), http.StatusInternalServerError},
+		{context.DeadlineExceeded, http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		fake.err = test.err
+		w := serve(g, "GET", "/v1/verses/43/3", "", "")
+		if w.Code != test.status {
+			t.Errorf("%v: status %d, want %d", test.err, w.Code, test.status)
+		}
+		var body struct {
+			Code    codes.Code `json:"code"`
+			Message string     `json:"message"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("%v: the error body %q is not JSON: %v", test.err, w.Body, err)
+		}
+		if st := status.Convert(test.err); body.Code != st.Code() || body.Message != st.Message() {
+			t.Errorf("%v: body %+v, want code %v and message %q", test.err, body, st.Code(), st.Message())
+		}
+	}
+
+	// every gRPC code has an HTTP status
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		if _, ok := httpStatus[code]; !ok {
+			t.Errorf("no HTTP status for %v", code)
+		}
+	}
+}
+
+func TestGatewayAuth(t *testing.T) {
+	g, fake := newTestGateway(t)
+	token := testToken(t)
+	tests := []struct {
+		name           string
+		method, target string
+		token          string
+		status         int
+		signedIn       bool
+	}{
+		{"a user RPC without a token", "GET", "/v1/me", "", http.StatusUnauthorized, false},
+		{"a user RPC with a bad token", "GET", "/v1/me", token + "x", http.StatusUnauthorized, false},
+		{"a user RPC with a token", "GET", "/v1/me", token, http.StatusOK, true},
+		{"an admin RPC without a token", "POST", "/v1/plans/Test/import", "", http.StatusUnauthorized, false},
+		{"a public RPC without a token", "GET", "/v1/verses/43/3", "", http.StatusOK, false},
+		{"a public RPC with a token", "GET", "/v1/verses/43/3", token, http.StatusOK, true},
+		{"a public RPC with a bad token", "GET", "/v1/verses/43/3", "not.a-token", http.StatusUnauthorized, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake.request, fake.user = nil, nil
+			w := serve(g, test.method, test.target, "", test.token)
+			if w.Code != test.status {
+				t.Fatalf("status %d, want %d: %s", w.Code, test.status, w.Body)
+			}
+			if called := fake.request != nil; called != (test.status == http.StatusOK) {
+				t.Errorf("RPC called %v, want %v", called, !called)
+			}
+			if signedIn := fake.user != nil; signedIn != test.signedIn {
+				t.Errorf("signed in %v, want %v", signedIn, test.signedIn)
+			}
+		})
+	}
+}
+
+func TestSetFieldEnum(t *testing.T) {
+	tests := []struct {
+		value string
+		want  typepb.Field_Kind
+		err   bool
+	}{
+		{"TYPE_STRING", typepb.Field_TYPE_STRING, false},
+		{"9", typepb.Field_TYPE_STRING, false},
+		{"TYPE_WORDS", 0, true},
+	}
+	for _, test := range tests {
+		field := &typepb.Field{}
+		err := setField(field.ProtoReflect(), "kind", []string{test.value})
+		if (err != nil) != test.err {
+			t.Errorf("setField(kind, %q) error %v, want error %v", test.value, err, test.err)
+			continue
+		}
+		if !test.err && field.GetKind() != test.want {
+			t.Errorf("setField(kind, %q) = %v, want %v", test.value, field.GetKind(), test.want)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// planScope is the Scripture a generated plan covers, books in reading order
+// and, for a chapter custom range, the chapters of each book to read
+type planScope struct {
+	books    []int32
+	chapters map[int32]map[int32]bool
+}
+
+// includes reports whether a chapter of a book is in the scope
+func (p planScope) includes(book, chapter int32) bool {
+	chapters, ok := p.chapters[book]
+	return !ok || chapters[chapter]
+}
+
+func (s server) GeneratePlan(ctx context.Context, request *wordsearcher.GeneratePlanRequest) (*wordsearcher.GeneratePlanResponse, error) {
+	// Functionality
+	// - Builds a plan reading a scope (books, a testament or a CustomRange) in a number
+	//   of days, balancing the days by verse, word or chapter count. keep_chapters never
+	//   splits a chapter, otherwise days may start and end mid chapter.
+	// - With tracks builds a plan of several readings a day like M'Cheyne's, each track
+	//   going through its own scope, cycles times, at its own pace over the same days.
+	// - Admin: saves the plan as BiblePlan tracks when save is set, replacing a plan
+	//   of the same name.
+	//
+	// **Error Handling
+	// - If save is set and the user is not a signed in admin return permission denied error
+	// - If the scope, strategy or days are invalid return invalid argument error
+	// - If the custom range does not exist return not found error
+	// - If a scope has fewer chapters or verses than days return out of range error
+
+	strategy := request.GetStrategy()
+	if strategy == "" {
+		strategy = "verses"
+	}
+	if strategy != "verses" && strategy != "words" && strategy != "chapters" {
+		return nil, status.Errorf(codes.InvalidArgument, "The strategy must be verses, words or chapters. Invalid: %v", strategy)
+	}
+	if request.GetDays() < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "The plan needs at least one day. Invalid: %v", request.GetDays())
+	}
+	if request.GetSave() {
+		if err := requireAdmin(ctx, "Saving a plan"); err != nil {
+			return nil, err
+		}
+		if request.GetName() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "A plan needs a name to be saved")
+		}
+	}
+
+	// a single scope is a plan of one track
+	trackScopes := request.GetTracks()
+	single := &wordsearcher.PlanTrackScope{
+		Books:       request.GetBooks(),
+		Testament:   request.GetTestament(),
+		CustomRange: request.GetCustomRange(),
+	}
+	if len(trackScopes) == 0 {
+		trackScopes = []*wordsearcher.PlanTrackScope{single}
+	} else if len(single.GetBooks()) > 0 || single.GetTestament() != "" || single.GetCustomRange() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The plan needs either a scope or tracks, not both")
+	}
+
+	var tracks []wsbible.PlanTrack
+	for i, trackScope := range trackScopes {
+		scope, err := s.generateScope(ctx, trackScope)
+		if err != nil {
+			return nil, err
+		}
+		units, lastVerse, err := planUnits(ctx, scope, strategy, request.GetKeepChapters(), request.GetTranslation())
+		if err != nil {
+			return nil, err
+		}
+		cycle := units
+		for c := int32(1); c < trackScope.GetCycles(); c++ {
+			units = append(units, cycle...)
+		}
+		partition, err := wsbible.PartitionPlan(units, int(request.GetDays()))
+		if err != nil {
+			return nil, status.Errorf(codes.OutOfRange, "Could not divide track %d: %v", i+1, err)
+		}
+
+		track := wsbible.PlanTrack{Name: request.GetName(), Number: int32(i + 1)}
+		for _, day := range partition {
+			track.Days = append(track.Days, wsbible.FormatReading(day, lastVerse))
+		}
+		tracks = append(tracks, track)
+	}
+	if request.GetSave() {
+		if err := savePlan(ctx, tracks); err != nil {
+			return nil, status.Errorf(codes.Internal, "Error saving the plan: %v", err)
+		}
+	}
+
+	// build the protocol buffer response
+	response := &wordsearcher.GeneratePlanResponse{}
+	for _, track := range tracks {
+		response.Tracks = append(response.Tracks, &wordsearcher.BiblePlan{
+			Name:   track.Name,
+			Number: track.Number,
+			Days:   track.Days,
+		})
+	}
+	response.BiblePlan = response.Tracks[0]
+	return response, nil
+}
+
+// generateScope resolves the scope of a plan track
+func (s server) generateScope(ctx context.Context, trackScope *wordsearcher.PlanTrackScope) (planScope, error) {
+	var scope planScope
+	given := 0
+	for _, set := range []bool{len(trackScope.GetBooks()) > 0, trackScope.GetTestament() != "", trackScope.GetCustomRange() != ""} {
+		if set {
+			given++
+		}
+	}
+	if given != 1 {
+		return scope, status.Errorf(codes.InvalidArgument, "The plan needs one scope: books, testament or custom_range")
+	}
+	if trackScope.GetCycles() < 0 {
+		return scope, status.Errorf(codes.InvalidArgument, "The cycles of a track cannot be negative. Invalid: %v", trackScope.GetCycles())
+	}
+
+	switch {
+	case len(trackScope.GetBooks()) > 0:
+		for _, number := range trackScope.GetBooks() {
+			if _, ok := wsbible.BookByNumber(number); !ok {
+				return scope, status.Errorf(codes.InvalidArgument, "The book must be between 1 and %d. Invalid: %v", len(wsbible.Books), number)
+			}
+			scope.books = append(scope.books, number)
+		}
+	case trackScope.GetTestament() != "":
+		first, last := int32(1), int32(39)
+		switch strings.ToLower(trackScope.GetTestament()) {
+		case "old", "ot":
+		case "new", "nt":
+			first, last = 40, 66
+		default:
+			return scope, status.Errorf(codes.InvalidArgument, "The testament must be old or new. Invalid: %v", trackScope.GetTestament())
+		}
+		for number := first; number <= last; number++ {
+			scope.books = append(scope.books, number)
+		}
+	default:
+		customRange, err := s.CustomRange(ctx, &wordsearcher.CustomRangeRequest{Name: trackScope.GetCustomRange()})
+		if err != nil {
+			return scope, err
+		}
+		// a chapter range lists chapters of booknumber, any other lists books
+		if r := customRange.GetCustomRange(); r.GetType() == "chapter" {
+			scope.books = []int32{r.GetBooknumber()}
+			scope.chapters = map[int32]map[int32]bool{r.GetBooknumber(): {}}
+			for _, chapter := range r.GetCustomrange() {
+				scope.chapters[r.GetBooknumber()][chapter] = true
+			}
+		} else {
+			scope.books = r.GetCustomrange()
+		}
+	}
+	return scope, nil
+}
+
+// planUnits lists the verses, or chapters, of the scope in reading order,
+// weighted by the strategy, and the last verse of each chapter
+func planUnits(ctx context.Context, scope planScope, strategy string, keepChapters bool, translation string) ([]wsbible.PlanUnit, map[[2]int32]int32, error) {
+	lastVerse := make(map[[2]int32]int32)
+	var units []wsbible.PlanUnit
+
+	// counting chapters only needs the book table
+	if strategy == "chapters" {
+		for _, number := range scope.books {
+			book, _ := wsbible.BookByNumber(number)
+			for chapter := int32(1); chapter <= book.Chapters; chapter++ {
+				if scope.includes(number, chapter) {
+					units = append(units, wsbible.PlanUnit{Book: number, Chapter: chapter, Weight: 1})
+				}
+			}
+		}
+		return units, lastVerse, nil
+	}
+
+	verseCollection := db.Database(config.Database).Collection(config.Collections.Verse)
+	books := bson.A{}
+	order := make(map[int32]int)
+	for i, number := range scope.books {
+		books = append(books, number)
+		order[number] = i
+	}
+	verseCursor, err := verseCollection.Aggregate(ctx, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{
+			"book":        bson.M{"$in": books},
+			"translation": translationFilter(translation),
+		}}},
+		bson.D{{Key: "$project", Value: bson.M{
+			"book":    1,
+			"chapter": 1,
+			"verse":   1,
+			"words":   bson.M{"$size": bson.M{"$split": bson.A{bson.M{"$trim": bson.M{"input": "$text"}}, " "}}},
+		}}},
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Error counting the verses of the scope: %v", err)
+	}
+	var verses []struct {
+		Book    int32 `bson:"book"`
+		Chapter int32 `bson:"chapter"`
+		Verse   int32 `bson:"verse"`
+		Words   int32 `bson:"words"`
+	}
+	if cursorErr := verseCursor.All(ctx, &verses); cursorErr != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Error decoding the cursor into verses: %v", cursorErr)
+	}
+	sort.Slice(verses, func(i, j int) bool {
+		a, b := verses[i], verses[j]
+		if a.Book != b.Book {
+			return order[a.Book] < order[b.Book]
+		}
+		if a.Chapter != b.Chapter {
+			return a.Chapter < b.Chapter
+		}
+		return a.Verse < b.Verse
+	})
+
+	for _, verse := range verses {
+		if !scope.includes(verse.Book, verse.Chapter) {
+			continue
+		}
+		key := [2]int32{verse.Book, verse.Chapter}
+		if verse.Verse > lastVerse[key] {
+			lastVerse[key] = verse.Verse
+		}
+		weight := float64(1)
+		if strategy == "words" {
+			weight = float64(verse.Words)
+		}
+
+		// whole chapters add the weight of each verse to the chapter
+		if keepChapters {
+			if n := len(units); n > 0 && units[n-1].Book == verse.Book && units[n-1].Chapter == verse.Chapter {
+				units[n-1].Weight += weight
+				continue
+			}
+			units = append(units, wsbible.PlanUnit{Book: verse.Book, Chapter: verse.Chapter, Weight: weight})
+			continue
+		}
+		units = append(units, wsbible.PlanUnit{Book: verse.Book, Chapter: verse.Chapter, Verse: verse.Verse, Weight: weight})
+	}
+	return units, lastVerse, nil
+}
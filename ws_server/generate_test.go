@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGeneratePlanRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *wordsearcher.GeneratePlanRequest
+		code    codes.Code
+	}{
+		{"anonymous save", &wordsearcher.GeneratePlanRequest{Name: "McCheyneBasedYearly", Days: 365, Testament: "new", Save: true}, codes.PermissionDenied},
+		{"anonymous save without a name", &wordsearcher.GeneratePlanRequest{Days: 365, Testament: "new", Save: true}, codes.PermissionDenied},
+		{"unknown strategy", &wordsearcher.GeneratePlanRequest{Days: 365, Testament: "new", Strategy: "pages"}, codes.InvalidArgument},
+		{"no days", &wordsearcher.GeneratePlanRequest{Testament: "new"}, codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := server{}.GeneratePlan(context.Background(), test.request)
+			if status.Code(err) != test.code {
+				t.Errorf("got %v, want %v", err, test.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// grpcWebHeaders are the request headers the gRPC-Web and REST clients send
+var grpcWebHeaders = []string{"authorization", "content-type", "grpc-timeout", "x-grpc-web", "x-user-agent"}
+
+// grpcWebServer lets browsers call the gRPC server with gRPC-Web over
+// HTTP/1.1, without an Envoy proxy, and hands every other request to the
+// REST gateway. Both get CORS headers for the allowed origins.
+type grpcWebServer struct {
+	grpcServer *grpc.Server
+	next       http.Handler
+	origins    map[string]bool
+	anyOrigin  bool
+}
+
+// newGRPCWebServer wraps the gRPC server, passing other requests on to next
+func newGRPCWebServer(grpcServer *grpc.Server, next http.Handler, origins []string) *grpcWebServer {
+	w := &grpcWebServer{
+		grpcServer: grpcServer,
+		next:       next,
+		origins:    make(map[string]bool),
+	}
+	for _, origin := range origins {
+		if origin == "*" {
+			w.anyOrigin = true
+		}
+		w.origins[strings.TrimSuffix(origin, "/")] = true
+	}
+	return w
+}
+
+func (g *grpcWebServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if origin := r.Header.Get("Origin"); origin != "" {
+		w.Header().Add("Vary", "Origin")
+		if !g.anyOrigin && !g.origins[origin] {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+		if g.anyOrigin {
+			// any site may call, but never with the user's credentials
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+		w.Header().Set("Access-Control-Expose-Headers", "grpc-status, grpc-message, grpc-status-details-bin")
+
+		// answer preflight requests here, they never reach a handler
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", strings.Join(grpcWebHeaders, ", "))
+			w.Header().Set("Access-Control-Max-Age", "600")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	if isGRPCWebRequest(r) {
+		g.serveGRPCWeb(w, r)
+		return
+	}
+	g.next.ServeHTTP(w, r)
+}
+
+// isGRPCWebRequest reports whether a request is a gRPC-Web call, binary
+// (application/grpc-web) or base64 text (application/grpc-web-text)
+func isGRPCWebRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc-web")
+}
+
+// serveGRPCWeb turns a gRPC-Web call into the HTTP/2 gRPC request the gRPC
+// server's ServeHTTP expects, and its response back into gRPC-Web with the
+// trailers in a final length prefixed frame of the body
+func (g *grpcWebServer) serveGRPCWeb(w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	text := strings.HasPrefix(contentType, "application/grpc-web-text")
+
+	call := r.Clone(r.Context())
+	call.ProtoMajor, call.ProtoMinor, call.Proto = 2, 0, "HTTP/2.0"
+	grpcContentType := strings.Replace(contentType, "application/grpc-web-text", "application/grpc-web", 1)
+	call.Header.Set("Content-Type", strings.Replace(grpcContentType, "application/grpc-web", "application/grpc", 1))
+	call.Header.Del("Content-Length")
+	call.ContentLength = -1
+	if text {
+		call.Body = ioutil.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+	}
+
+	response := &grpcWebResponse{
+		w:           w,
+		header:      make(http.Header),
+		contentType: contentType,
+		text:        text,
+	}
+	g.grpcServer.ServeHTTP(response, call)
+	response.finish()
+}
+
+// grpcWebResponse is the http.ResponseWriter the gRPC server writes to. The
+// status and trailers it sets on the header after the body become the
+// trailer frame, and text responses are base64 encoded as a whole, which is
+// fine as every RPC is unary.
+type grpcWebResponse struct {
+	w             http.ResponseWriter
+	header        http.Header
+	contentType   string
+	text          bool
+	headerWritten bool
+	body          bytes.Buffer // text responses, encoded in finish
+}
+
+// trailerHeaders are set by the gRPC server once the response is done
+var trailerHeaders = map[string]bool{
+	"Grpc-Status":             true,
+	"Grpc-Message":            true,
+	"Grpc-Status-Details-Bin": true,
+}
+
+func (r *grpcWebResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *grpcWebResponse) WriteHeader(code int) {
+	if r.headerWritten {
+		return
+	}
+	r.headerWritten = true
+	for key, values := range r.header {
+		if key == "Trailer" || trailerHeaders[key] || strings.HasPrefix(key, http.TrailerPrefix) {
+			continue
+		}
+		r.w.Header()[key] = values
+	}
+	r.w.Header().Set("Content-Type", r.contentType)
+	r.w.WriteHeader(code)
+}
+
+func (r *grpcWebResponse) Write(data []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	if r.text {
+		return r.body.Write(data)
+	}
+	return r.w.Write(data)
+}
+
+func (r *grpcWebResponse) Flush() {
+	r.WriteHeader(http.StatusOK)
+	if flusher, ok := r.w.(http.Flusher); ok && !r.text {
+		flusher.Flush()
+	}
+}
+
+// finish writes the trailer frame, flag 0x80 then the length and the
+// trailers as HTTP/1 header lines, and the encoded body of text responses
+func (r *grpcWebResponse) finish() {
+	r.WriteHeader(http.StatusOK)
+
+	var names []string
+	trailers := make(map[string][]string)
+	for key, values := range r.header {
+		name := strings.TrimPrefix(key, http.TrailerPrefix)
+		if trailerHeaders[key] || name != key {
+			name = strings.ToLower(name)
+			names = append(names, name)
+			trailers[name] = values
+		}
+	}
+	sort.Strings(names)
+	var lines bytes.Buffer
+	for _, name := range names {
+		for _, value := range trailers[name] {
+			fmt.Fprintf(&lines, "%s: %s\r\n", name, value)
+		}
+	}
+
+	frame := make([]byte, 5, 5+lines.Len())
+	frame[0] = 0x80
+	binary.BigEndian.PutUint32(frame[1:], uint32(lines.Len()))
+	frame = append(frame, lines.Bytes()...)
+
+	if r.text {
+		r.body.Write(frame)
+		_, _ = r.w.Write([]byte(base64.StdEncoding.EncodeToString(r.body.Bytes())))
+	} else {
+		_, _ = r.w.Write(frame)
+	}
+	if flusher, ok := r.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
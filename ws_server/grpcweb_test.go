@@ -0,0 +1,43 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGRPCWebCORS(t *testing.T) {
+	tests := []struct {
+		name        string
+		origins     []string
+		origin      string
+		status      int
+		allowOrigin string
+		credentials string
+	}{
+		{"allowed origin", []string{"http://localhost:4200"}, "http://localhost:4200", http.StatusNoContent, "http://localhost:4200", "true"},
+		{"allowed origin with a slash", []string{"http://localhost:4200/"}, "http://localhost:4200", http.StatusNoContent, "http://localhost:4200", "true"},
+		{"other origin", []string{"http://localhost:4200"}, "https://evil.example", http.StatusForbidden, "", ""},
+		{"any origin", []string{"*"}, "https://evil.example", http.StatusNoContent, "*", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := newGRPCWebServer(nil, http.NotFoundHandler(), test.origins)
+			r := httptest.NewRequest(http.MethodOptions, "/v1/verses", nil)
+			r.Header.Set("Origin", test.origin)
+			r.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != test.status {
+				t.Errorf("status %d, want %d", w.Code, test.status)
+			}
+			if got := w.Header().Get("Access-Control-Allow-Origin"); got != test.allowOrigin {
+				t.Errorf("Access-Control-Allow-Origin %q, want %q", got, test.allowOrigin)
+			}
+			if got := w.Header().Get("Access-Control-Allow-Credentials"); got != test.credentials {
+				t.Errorf("Access-Control-Allow-Credentials %q, want %q", got, test.credentials)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// serviceName is the name probes check the WordsearcherService by, the
+// overall health of the server is the blank name
+const serviceName = "wordsearcher.WordsearcherService"
+
+// watchDatabase pings MongoDB every health check interval until ctx is done,
+// reporting the server NOT_SERVING while the database is unreachable
+func watchDatabase(ctx context.Context, healthServer *health.Server) {
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	check := func() {
+		pingCtx, cancel := context.WithTimeout(ctx, config.HealthCheckTimeout)
+		defer cancel()
+		servingStatus := healthpb.HealthCheckResponse_SERVING
+		err := db.Ping(pingCtx, nil)
+		if err != nil {
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		if servingStatus != last {
+			if err != nil {
+				log.Printf("MongoDB is unreachable, reporting %v: %v", servingStatus, err)
+			} else {
+				log.Printf("MongoDB is reachable, reporting %v", servingStatus)
+			}
+			last = servingStatus
+		}
+		healthServer.SetServingStatus("", servingStatus)
+		healthServer.SetServingStatus(serviceName, servingStatus)
+	}
+
+	check()
+	ticker := time.NewTicker(config.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			check()
+		}
+	}
+}
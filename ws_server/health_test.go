@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jwjones2/wordsearcher-server/wsconfig"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// servingStatus is what a probe of the service name sees, or the error
+func servingStatus(healthServer *health.Server, service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	response, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, err
+	}
+	return response.GetStatus(), nil
+}
+
+func TestWatchDatabase(t *testing.T) {
+	// nothing listens on port 1, so every ping fails once the server
+	// selection timeout is up
+	client, err := mongo.Connect(context.Background(), options.Client().
+		ApplyURI("mongodb://127.0.0.1:1").
+		SetServerSelectionTimeout(50*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	savedDB, savedConfig := db, config
+	t.Cleanup(func() {
+		_ = client.Disconnect(context.Background())
+		db, config = savedDB, savedConfig
+	})
+	db = client
+	config = wsconfig.Default()
+	config.HealthCheckInterval = 20 * time.Millisecond
+	config.HealthCheckTimeout = 100 * time.Millisecond
+
+	healthServer := health.NewServer()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		watchDatabase(ctx, healthServer)
+		close(done)
+	}()
+
+	// the first ping reports both the server and the service
+	deadline := time.Now().Add(5 * time.Second)
+	for _, service := range []string{"", serviceName} {
+		for {
+			got, err := servingStatus(healthServer, service)
+			if err == nil && got == healthpb.HealthCheckResponse_NOT_SERVING {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("service %q is %v (%v), want NOT_SERVING", service, got, err)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// the watch ends with its context
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watchDatabase did not return after its context was done")
+	}
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InterlinearVerse struct for the interlinear collection, the source language
+// tokens of one verse (imported with ws_import interlinear)
+type InterlinearVerse struct {
+	ID       primitive.ObjectID `bson:"id"`
+	Book     int32              `bson:"book"`
+	Chapter  int32              `bson:"chapter"`
+	Verse    int32              `bson:"verse"`
+	Source   string             `bson:"source"`
+	Language string             `bson:"language"`
+	Tokens   []InterlinearToken `bson:"tokens"`
+}
+
+// InterlinearToken struct for one source language word
+type InterlinearToken struct {
+	Position int32  `bson:"position"`
+	Text     string `bson:"text"`
+	Lemma    string `bson:"lemma"`
+	Strongs  string `bson:"strongs"`
+	Morph    string `bson:"morph"`
+	Gloss    string `bson:"gloss"`
+}
+
+func (s server) Interlinear(ctx context.Context, request *wordsearcher.InterlinearRequest) (*wordsearcher.InterlinearResponse, error) {
+	// Functionality
+	// - Returns the Greek or Hebrew tokens of a verse range with their morphology, lemma and Strong's number.
+	// - Tokens missing a lemma, Strong's number or gloss are filled in from the lexicon.
+	// - Tokens are aligned to the words of the English verse sharing their Strong's number, when
+	//   the English verse is tagged.
+	//
+	// **Error Handling
+	// - Same verse range rules as Verse.
+
+	if request.GetVerseStart() < 0 {
+		return nil, status.Errorf(codes.OutOfRange, "The verse range must be positive. Invalid: %v", request.GetVerseStart())
+	}
+	if request.GetVerseStart() > request.GetVerseEnd() {
+		return nil, status.Errorf(codes.OutOfRange,
+			"The start of the verse range cannot be greater than the end. Invalid: Verse Start: %v; Verse End: %v",
+			request.GetVerseStart(), request.GetVerseEnd())
+	}
+
+	// build the filter
+	filter := bson.M{
+		"book":    request.GetBook(),
+		"chapter": request.GetChapter(),
+	}
+	if request.GetVerseStart() > 0 {
+		filter["verse"] = bson.M{
+			"$gte": request.GetVerseStart(),
+			"$lte": request.GetVerseEnd(),
+		}
+	}
+
+	// do the Database call and store the results
+	interlinearCollection := db.Database(config.Database).Collection(config.Collections.Interlinear)
+	var interlinearVerses []*InterlinearVerse
+	interlinearCursor, err := interlinearCollection.Find(ctx, filter, options.Find().SetSort(bson.M{"verse": 1}))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Error finding the interlinear verses: %v", err)
+	}
+	if cursorErr := interlinearCursor.All(ctx, &interlinearVerses); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into interlinear verses: %v", cursorErr)
+	}
+
+	// the English verses to align to
+	englishResponse, err := s.Verse(ctx, &wordsearcher.VerseRequest{
+		Book:       request.GetBook(),
+		Chapter:    request.GetChapter(),
+		VerseStart: request.GetVerseStart(),
+		VerseEnd:   request.GetVerseEnd(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	english := make(map[int32]*wordsearcher.Verse)
+	for _, verse := range englishResponse.GetVerses() {
+		english[verse.GetVerse()] = verse
+	}
+
+	lexicon, err := lexiconForTokens(ctx, interlinearVerses)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the lexicon entries: %v", err)
+	}
+
+	// build the protocol buffer response
+	var interlinearResponses []*wordsearcher.InterlinearVerse
+	for _, verse := range interlinearVerses {
+		englishVerse := english[verse.Verse]
+		verseResponse := &wordsearcher.InterlinearVerse{
+			Book:     verse.Book,
+			Chapter:  verse.Chapter,
+			Verse:    verse.Verse,
+			Source:   verse.Source,
+			Language: verse.Language,
+			English:  englishVerse,
+		}
+		for _, token := range verse.Tokens {
+			tokenResponse := &wordsearcher.InterlinearToken{
+				Position: token.Position,
+				Text:     token.Text,
+				Lemma:    token.Lemma,
+				Strongs:  token.Strongs,
+				Morph:    token.Morph,
+				Gloss:    token.Gloss,
+			}
+			if tokenResponse.Strongs == "" {
+				if entry, ok := lexicon[token.Lemma]; ok {
+					tokenResponse.Strongs = entry.Strongs
+				}
+			}
+			if tokenResponse.Lemma == "" {
+				if entry, ok := lexicon[token.Strongs]; ok {
+					tokenResponse.Lemma = entry.Lemma
+				}
+			}
+			if tokenResponse.Strongs != "" && englishVerse != nil {
+				tokenResponse.English = alignedWords(englishVerse, tokenResponse.Strongs)
+			}
+			if tokenResponse.Gloss == "" {
+				tokenResponse.Gloss = tokenGloss(englishVerse, tokenResponse, lexicon)
+			}
+			verseResponse.Tokens = append(verseResponse.Tokens, tokenResponse)
+		}
+		interlinearResponses = append(interlinearResponses, verseResponse)
+	}
+
+	return &wordsearcher.InterlinearResponse{
+		Verses: interlinearResponses,
+	}, nil
+}
+
+// lexiconForTokens looks up the lexicon entries for the Strong's numbers and
+// lemmas of the tokens, keyed by both
+func lexiconForTokens(ctx context.Context, verses []*InterlinearVerse) (map[string]*LexiconEntry, error) {
+	var numbers, lemmas bson.A
+	for _, verse := range verses {
+		for _, token := range verse.Tokens {
+			if token.Strongs != "" {
+				numbers = append(numbers, token.Strongs)
+			}
+			if token.Lemma != "" {
+				lemmas = append(lemmas, token.Lemma)
+			}
+		}
+	}
+	lexicon := make(map[string]*LexiconEntry)
+	if len(numbers) == 0 && len(lemmas) == 0 {
+		return lexicon, nil
+	}
+
+	lexiconCollection := db.Database(config.Database).Collection(config.Collections.Lexicon)
+	lexiconCursor, err := lexiconCollection.Find(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"strongs": bson.M{"$in": numbers}},
+			bson.M{"lemma": bson.M{"$in": lemmas}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var entries []*LexiconEntry
+	if err := lexiconCursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		lexicon[entry.Strongs] = entry
+		if entry.Lemma != "" {
+			lexicon[entry.Lemma] = entry
+		}
+	}
+	return lexicon, nil
+}
+
+// alignedWords returns the indexes of the English words tagged with the Strong's number
+func alignedWords(verse *wordsearcher.Verse, strongs string) []int32 {
+	var indexes []int32
+	for i, word := range verse.GetWords() {
+		for _, number := range word.GetStrongs() {
+			if number == strongs {
+				indexes = append(indexes, int32(i))
+				break
+			}
+		}
+	}
+	return indexes
+}
+
+// tokenGloss glosses a token with its aligned English words, falling back to
+// the first sense of the lexicon's KJV usage
+func tokenGloss(verse *wordsearcher.Verse, token *wordsearcher.InterlinearToken, lexicon map[string]*LexiconEntry) string {
+	if len(token.English) > 0 {
+		var words []string
+		for _, i := range token.English {
+			words = append(words, verse.GetWords()[i].GetText())
+		}
+		return strings.Join(words, " ")
+	}
+	entry, ok := lexicon[token.Strongs]
+	if !ok {
+		return ""
+	}
+	gloss := entry.KJVUsage
+	if i := strings.IndexAny(gloss, ",;"); i >= 0 {
+		gloss = gloss[:i]
+	}
+	return strings.TrimSpace(gloss)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LexiconEntry struct for the lexicon collection, one Strong's dictionary entry
+// per document (imported with ws_import lexicon)
+type LexiconEntry struct {
+	ID              primitive.ObjectID `bson:"id"`
+	Strongs         string             `bson:"strongs"`
+	Lemma           string             `bson:"lemma"`
+	Transliteration string             `bson:"transliteration"`
+	Pronunciation   string             `bson:"pronunciation"`
+	Definition      string             `bson:"definition"`
+	KJVUsage        string             `bson:"kjv_usage"`
+	Derivation      string             `bson:"derivation"`
+}
+
+func (s server) Lexicon(ctx context.Context, request *wordsearcher.LexiconRequest) (*wordsearcher.LexiconResponse, error) {
+	// Functionality
+	// - Looks up a Strong's number in the lexicon and counts the tagged words carrying it.
+	//
+	// **Error Handling
+	// - If the Strong's number is malformed return invalid argument error
+	// - If the number is not in the lexicon return not found error
+
+	strongs, err := wsbible.NormalizeStrongs(request.GetStrongs())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Strong's number: %v", err)
+	}
+
+	// find the dictionary entry
+	lexiconCollection := db.Database(config.Database).Collection(config.Collections.Lexicon)
+	var entry LexiconEntry
+	if err := lexiconCollection.FindOne(ctx, bson.M{"strongs": strongs}).Decode(&entry); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Errorf(codes.NotFound, "Could not find %s in the lexicon.", strongs)
+		}
+		return nil, status.Errorf(codes.Internal, "Error finding the lexicon entry: %v", err)
+	}
+
+	// count the occurrences, a verse can carry the same number on several words
+	// so unwind the words and count those rather than the verses
+	verseCollection := db.Database(config.Database).Collection(config.Collections.Verse)
+	occurrenceCursor, err := verseCollection.Aggregate(ctx, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{"words.strongs": strongs}}},
+		bson.D{{Key: "$unwind", Value: "$words"}},
+		bson.D{{Key: "$match", Value: bson.M{"words.strongs": strongs}}},
+		bson.D{{Key: "$count", Value: "occurrences"}},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not count the occurrences of %s: %v", strongs, err)
+	}
+	var counts []struct {
+		Occurrences int32 `bson:"occurrences"`
+	}
+	if cursorErr := occurrenceCursor.All(ctx, &counts); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the occurrence count: %v", cursorErr)
+	}
+	var occurrences int32
+	if len(counts) > 0 {
+		occurrences = counts[0].Occurrences
+	}
+
+	return &wordsearcher.LexiconResponse{
+		Entry: &wordsearcher.LexiconEntry{
+			Strongs:         entry.Strongs,
+			Lemma:           entry.Lemma,
+			Transliteration: entry.Transliteration,
+			Pronunciation:   entry.Pronunciation,
+			Definition:      entry.Definition,
+			KjvUsage:        entry.KJVUsage,
+			Derivation:      entry.Derivation,
+			Occurrences:     occurrences,
+		},
+	}, nil
+}
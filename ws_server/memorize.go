@@ -0,0 +1,389 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MemoryCard struct for the memorycard collection, a passage in a user's
+// memorization deck with its SM-2 review schedule
+type MemoryCard struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	UserID       primitive.ObjectID `bson:"user_id"`
+	Reference    string             `bson:"reference"`
+	Translation  string             `bson:"translation"`
+	Ease         float64            `bson:"ease"`
+	Interval     int32              `bson:"interval"`
+	Repetitions  int32              `bson:"repetitions"`
+	Lapses       int32              `bson:"lapses"`
+	Due          time.Time          `bson:"due"`
+	LastReviewed time.Time          `bson:"last_reviewed,omitempty"`
+	Reviews      int32              `bson:"reviews"`
+	Created      time.Time          `bson:"created"`
+}
+
+// matureInterval is the review interval from which a passage counts as memorized
+const matureInterval = 21
+
+// defaultClozeDifficulty is the share of words a cloze prompt hides by default
+const defaultClozeDifficulty = 0.3
+
+// Memory deck list limits
+const (
+	defaultMemoryCardLimit = 100
+	maxMemoryCardLimit     = 500
+)
+
+// proto converts the card into its protocol buffer message
+func (m *MemoryCard) proto() *wordsearcher.MemoryCard {
+	card := &wordsearcher.MemoryCard{
+		Id:          m.ID.Hex(),
+		Reference:   m.Reference,
+		Translation: m.Translation,
+		Ease:        m.Ease,
+		Interval:    m.Interval,
+		Repetitions: m.Repetitions,
+		Lapses:      m.Lapses,
+		Due:         m.Due.Unix(),
+		Reviews:     m.Reviews,
+		Created:     m.Created.Unix(),
+	}
+	if !m.LastReviewed.IsZero() {
+		card.LastReviewed = m.LastReviewed.Unix()
+	}
+	return card
+}
+
+// review is the SM-2 schedule of the card
+func (m *MemoryCard) review() wsbible.Review {
+	return wsbible.Review{
+		Ease:        m.Ease,
+		Interval:    m.Interval,
+		Repetitions: m.Repetitions,
+		Lapses:      m.Lapses,
+	}
+}
+
+func (s server) AddMemoryVerse(ctx context.Context, request *wordsearcher.AddMemoryVerseRequest) (*wordsearcher.MemoryCardResponse, error) {
+	// Functionality
+	// - Adds a passage to the signed in user's memorization deck, due for review now.
+	// - A blank translation is the default text, and is stored blank, so the deck
+	//   can only hold a passage of the default text once.
+	//
+	// **Error Handling
+	// - If the reference does not parse return invalid argument error
+	// - If the passage has no verses in the translation return not found error
+	// - If the passage is already in the deck return already exists error
+
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	passage, err := wsbible.ParseReference(request.GetReference())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid reference %q: %v", request.GetReference(), err)
+	}
+	translation := strings.TrimSpace(request.GetTranslation())
+	if _, err := s.passageText(ctx, passage, translation); err != nil {
+		return nil, err
+	}
+
+	cardCollection, err := memoryCardsCollection(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error preparing the memory card collection: %v", err)
+	}
+	now := time.Now()
+	card := &MemoryCard{
+		UserID:      userID,
+		Reference:   passage.String(),
+		Translation: translation,
+		Ease:        wsbible.InitialEase,
+		Due:         now,
+		Created:     now,
+	}
+	res, err := cardCollection.InsertOne(ctx, card)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, status.Errorf(codes.AlreadyExists, "%s is already in the deck", card.Reference)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error saving the memory card: %v", err)
+	}
+	card.ID = res.InsertedID.(primitive.ObjectID)
+
+	return &wordsearcher.MemoryCardResponse{
+		Card: card.proto(),
+	}, nil
+}
+
+func (s server) RemoveMemoryVerse(ctx context.Context, request *wordsearcher.RemoveMemoryVerseRequest) (*wordsearcher.RemoveMemoryVerseResponse, error) {
+	// Functionality
+	// - Removes a passage from the signed in user's deck, with its review history.
+	//
+	// **Error Handling
+	// - If the card is not the user's return not found error
+
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := primitive.ObjectIDFromHex(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid memory card id: %v", request.GetId())
+	}
+
+	cardCollection := db.Database(config.Database).Collection(config.Collections.MemoryCard)
+	res, err := cardCollection.DeleteOne(ctx, bson.M{"_id": id, "user_id": userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error deleting the memory card: %v", err)
+	}
+	if res.DeletedCount == 0 {
+		return nil, status.Errorf(codes.NotFound, "There is no memory card %s", id.Hex())
+	}
+	return &wordsearcher.RemoveMemoryVerseResponse{}, nil
+}
+
+func (s server) MemoryDeck(ctx context.Context, request *wordsearcher.MemoryDeckRequest) (*wordsearcher.MemoryDeckResponse, error) {
+	// Functionality
+	// - Lists the signed in user's deck, soonest due first, or only the cards due now.
+	// - Returns defaultMemoryCardLimit cards when no limit is given and at most
+	//   maxMemoryCardLimit.
+
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"user_id": userID}
+	if request.GetDueOnly() {
+		filter["due"] = bson.M{"$lte": time.Now()}
+	}
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "due", Value: 1}, {Key: "created", Value: 1}}).
+		SetLimit(listLimit(request.GetLimit(), defaultMemoryCardLimit, maxMemoryCardLimit))
+
+	cardCollection := db.Database(config.Database).Collection(config.Collections.MemoryCard)
+	var cards []*MemoryCard
+	cardCursor, err := cardCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the memory cards: %v", err)
+	}
+	if cursorErr := cardCursor.All(ctx, &cards); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into memory cards: %v", cursorErr)
+	}
+
+	// build the protocol buffer response
+	var cardResponses []*wordsearcher.MemoryCard
+	for _, card := range cards {
+		cardResponses = append(cardResponses, card.proto())
+	}
+	return &wordsearcher.MemoryDeckResponse{
+		Cards: cardResponses,
+	}, nil
+}
+
+func (s server) MemoryPrompt(ctx context.Context, request *wordsearcher.MemoryPromptRequest) (*wordsearcher.MemoryPromptResponse, error) {
+	// Functionality
+	// - Builds a recall prompt from the text of a card: a cloze with some of the
+	//   words blanked out, the first letter of each word, or the full text to read.
+	//
+	// **Error Handling
+	// - If the mode is unknown or the difficulty is not between 0 and 1 return invalid argument error
+	// - If the card is not the user's return not found error
+
+	card, err := findMemoryCard(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	passage, err := wsbible.ParseReference(card.Reference)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "The memory card has an invalid reference %q: %v", card.Reference, err)
+	}
+	text, err := s.passageText(ctx, passage, card.Translation)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &wordsearcher.MemoryPromptResponse{
+		Card: card.proto(),
+	}
+	switch request.GetMode() {
+	case "", "cloze":
+		difficulty := request.GetDifficulty()
+		if difficulty == 0 {
+			difficulty = defaultClozeDifficulty
+		}
+		if difficulty < 0 || difficulty > 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "The difficulty must be between 0 and 1. Invalid: %v", request.GetDifficulty())
+		}
+		seed := request.GetSeed()
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		prompt, hidden := wsbible.Cloze(text, difficulty, seed)
+		response.Prompt = prompt
+		response.HiddenWords = int32(len(hidden))
+	case "first_letter":
+		response.Prompt = wsbible.FirstLetters(text)
+	case "full":
+		response.Prompt = text
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "The mode must be cloze, first_letter or full. Invalid: %v", request.GetMode())
+	}
+	return response, nil
+}
+
+func (s server) ReviewMemoryVerse(ctx context.Context, request *wordsearcher.ReviewMemoryVerseRequest) (*wordsearcher.ReviewMemoryVerseResponse, error) {
+	// Functionality
+	// - Grades a recall typed from memory against the passage, or takes the
+	//   user's own grade when nothing is typed, and schedules the next review.
+	//
+	// **Error Handling
+	// - If the self grade is not between 0 and 5 return invalid argument error
+	// - If the card is not the user's return not found error
+
+	card, err := findMemoryCard(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	passage, err := wsbible.ParseReference(card.Reference)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "The memory card has an invalid reference %q: %v", card.Reference, err)
+	}
+	text, err := s.passageText(ctx, passage, card.Translation)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &wordsearcher.ReviewMemoryVerseResponse{
+		Text: text,
+	}
+	if strings.TrimSpace(request.GetAnswer()) != "" {
+		response.Score, response.MissedWords = wsbible.GradeRecall(text, request.GetAnswer())
+		response.Quality = wsbible.RecallQuality(response.Score)
+	} else {
+		if request.GetQuality() < 0 || request.GetQuality() > 5 {
+			return nil, status.Errorf(codes.InvalidArgument, "The quality must be between 0 and 5. Invalid: %v", request.GetQuality())
+		}
+		response.Quality = request.GetQuality()
+	}
+
+	// schedule the next review from today
+	next := card.review().Next(response.Quality)
+	now := time.Now()
+	card.Ease, card.Interval, card.Repetitions, card.Lapses = next.Ease, next.Interval, next.Repetitions, next.Lapses
+	card.Due = now.AddDate(0, 0, int(next.Interval))
+	card.LastReviewed = now
+	card.Reviews++
+
+	cardCollection := db.Database(config.Database).Collection(config.Collections.MemoryCard)
+	if _, err := cardCollection.ReplaceOne(ctx, bson.M{"_id": card.ID, "user_id": card.UserID}, card); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error saving the memory card: %v", err)
+	}
+	response.Card = card.proto()
+	return response, nil
+}
+
+func (s server) MemoryProgress(ctx context.Context, request *wordsearcher.MemoryProgressRequest) (*wordsearcher.MemoryProgressResponse, error) {
+	// Functionality
+	// - Sums up the signed in user's deck: cards due, new, learning and
+	//   memorized (mature), reviews, lapses and the average ease.
+
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cardCollection := db.Database(config.Database).Collection(config.Collections.MemoryCard)
+	var cards []*MemoryCard
+	cardCursor, err := cardCollection.Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the memory cards: %v", err)
+	}
+	if cursorErr := cardCursor.All(ctx, &cards); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into memory cards: %v", cursorErr)
+	}
+
+	progress := &wordsearcher.MemoryProgressResponse{
+		Cards: int32(len(cards)),
+	}
+	now := time.Now()
+	var ease float64
+	for _, card := range cards {
+		if !card.Due.After(now) {
+			progress.Due++
+		}
+		switch {
+		case card.Reviews == 0:
+			progress.New++
+		case card.Interval >= matureInterval:
+			progress.Mature++
+		default:
+			progress.Learning++
+		}
+		progress.Reviews += card.Reviews
+		progress.Lapses += card.Lapses
+		ease += card.Ease
+	}
+	if len(cards) > 0 {
+		progress.AverageEase = ease / float64(len(cards))
+	}
+	return progress, nil
+}
+
+// findMemoryCard loads a card of the signed in user
+func findMemoryCard(ctx context.Context, hexID string) (*MemoryCard, error) {
+	userID, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := primitive.ObjectIDFromHex(hexID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid memory card id: %v", hexID)
+	}
+
+	cardCollection := db.Database(config.Database).Collection(config.Collections.MemoryCard)
+	var card *MemoryCard
+	err = cardCollection.FindOne(ctx, bson.M{"_id": id, "user_id": userID}).Decode(&card)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "There is no memory card %s", id.Hex())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the memory card: %v", err)
+	}
+	return card, nil
+}
+
+// passageText joins the text of the verses of a passage
+func (s server) passageText(ctx context.Context, passage wsbible.Reference, translation string) (string, error) {
+	verses, err := s.passageVerses(ctx, passage, translation)
+	if err != nil {
+		return "", err
+	}
+	if len(verses) == 0 {
+		return "", status.Errorf(codes.NotFound, "There are no verses for %s", passage.String())
+	}
+	var texts []string
+	for _, verse := range verses {
+		texts = append(texts, strings.TrimSpace(verse.GetText()))
+	}
+	return strings.Join(texts, " "), nil
+}
+
+// memoryCardsCollection returns the memory card collection, making sure a
+// passage is only once in a user's deck
+func memoryCardsCollection(ctx context.Context) (*mongo.Collection, error) {
+	cardCollection := db.Database(config.Database).Collection(config.Collections.MemoryCard)
+	_, err := cardCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "reference", Value: 1}, {Key: "translation", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return cardCollection, err
+}
@@ -0,0 +1,393 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// openAPI builds the OpenAPI 3 document of the REST gateway from the
+// descriptors of ws.proto and the routes, so it never drifts from either
+type openAPI struct {
+	schemas map[string]interface{}
+}
+
+// openAPIDocument returns the OpenAPI 3 document of the REST routes, failing
+// on a route that names an RPC, path parameter or body field ws.proto lacks
+func openAPIDocument() (map[string]interface{}, error) {
+	o := &openAPI{schemas: make(map[string]interface{})}
+	o.schemas["Status"] = map[string]interface{}{
+		"type":        "object",
+		"description": "A google.rpc.Status error, code is the gRPC status code",
+		"properties": map[string]interface{}{
+			"code":    map[string]interface{}{"type": "integer", "format": "int32"},
+			"message": map[string]interface{}{"type": "string"},
+			"details": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "object"}},
+		},
+	}
+
+	service := wordsearcher.File_wspb_ws_proto.Services().ByName("WordsearcherService")
+	paths := make(map[string]interface{})
+	for _, route := range restRoutes {
+		method := service.Methods().ByName(protoreflect.Name(route.rpc))
+		if method == nil {
+			return nil, fmt.Errorf("the route %s %s names an unknown RPC %s", route.method, route.pattern, route.rpc)
+		}
+		operation, err := o.operation(route, method)
+		if err != nil {
+			return nil, fmt.Errorf("the route %s %s: %v", route.method, route.pattern, err)
+		}
+		path, ok := paths[route.pattern].(map[string]interface{})
+		if !ok {
+			path = make(map[string]interface{})
+			paths[route.pattern] = path
+		}
+		path[strings.ToLower(route.method)] = operation
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "WordSearcher API",
+			"version":     "v1",
+			"description": "REST/JSON endpoints of the gRPC WordsearcherService. Field names are the lowerCamelCase JSON names of ws.proto, 64 bit integers are strings.",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": o.schemas,
+			"securitySchemes": map[string]interface{}{
+				"bearerAuth": map[string]interface{}{
+					"type":         "http",
+					"scheme":       "bearer",
+					"description":  "The token of the Login or Register response",
+					"bearerFormat": "token",
+				},
+			},
+		},
+	}, nil
+}
+
+// operation describes one route: its path, query and body parameters and
+// the response and error schemas
+func (o *openAPI) operation(route restRoute, method protoreflect.MethodDescriptor) (map[string]interface{}, error) {
+	input := method.Input()
+	var parameters []interface{}
+
+	// path parameters, then every other scalar field as a query parameter
+	bound := make(map[string]bool)
+	for _, segment := range strings.Split(route.pattern, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name := segment[1 : len(segment)-1]
+			field, err := pathField(input, name)
+			if err != nil {
+				return nil, err
+			}
+			bound[name] = true
+			parameters = append(parameters, map[string]interface{}{
+				"name":     name,
+				"in":       "path",
+				"required": true,
+				"schema":   o.fieldSchema(field),
+			})
+		}
+	}
+	if route.body != "*" {
+		aliasOf := make(map[string]string)
+		for alias, field := range route.aliases {
+			aliasOf[field] = alias
+		}
+		fields := input.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			name := string(field.Name())
+			if bound[name] || field.Kind() == protoreflect.MessageKind || name == route.body {
+				continue
+			}
+			if alias, ok := aliasOf[name]; ok {
+				name = alias
+			}
+			schema := o.fieldSchema(field)
+			if field.IsList() {
+				schema = map[string]interface{}{"type": "array", "items": schema}
+			}
+			parameters = append(parameters, map[string]interface{}{
+				"name":   name,
+				"in":     "query",
+				"schema": schema,
+			})
+		}
+	}
+
+	operation := map[string]interface{}{
+		"operationId": route.rpc,
+		"tags":        []string{routeTag(route.pattern)},
+		"summary":     route.rpc + " RPC",
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{
+				"description": "OK",
+				"content":     jsonContent(o.messageRef(method.Output())),
+			},
+			"default": map[string]interface{}{
+				"description": "Error",
+				"content":     jsonContent(map[string]interface{}{"$ref": "#/components/schemas/Status"}),
+			},
+		},
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+	if route.body != "" {
+		body := input
+		if route.body != "*" {
+			field := input.Fields().ByName(protoreflect.Name(route.body))
+			if field == nil || field.Kind() != protoreflect.MessageKind || field.IsList() {
+				return nil, fmt.Errorf("the body %s is not a message field of %s", route.body, input.Name())
+			}
+			body = field.Message()
+		}
+		operation["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content":  jsonContent(o.messageRef(body)),
+		}
+	}
+	if userMethods[route.rpc] || adminMethods[route.rpc] {
+		operation["security"] = []interface{}{map[string]interface{}{"bearerAuth": []string{}}}
+	}
+	if adminMethods[route.rpc] {
+		operation["description"] = "Only for admins."
+	}
+	return operation, nil
+}
+
+// pathField finds the scalar field a dotted path parameter names
+func pathField(message protoreflect.MessageDescriptor, path string) (protoreflect.FieldDescriptor, error) {
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		field := message.Fields().ByName(protoreflect.Name(name))
+		if field == nil || field.Kind() != protoreflect.MessageKind || field.IsList() {
+			return nil, fmt.Errorf("the path parameter %s is not a field of %s", path, message.Name())
+		}
+		message = field.Message()
+	}
+	field := message.Fields().ByName(protoreflect.Name(names[len(names)-1]))
+	if field == nil || field.Kind() == protoreflect.MessageKind || field.IsList() || field.IsMap() {
+		return nil, fmt.Errorf("the path parameter %s is not a field of %s", path, message.Name())
+	}
+	return field, nil
+}
+
+// routeTag groups routes by the first segment after the version
+func routeTag(pattern string) string {
+	segments := strings.Split(strings.Trim(pattern, "/"), "/")
+	if len(segments) > 1 {
+		return segments[1]
+	}
+	return segments[0]
+}
+
+func jsonContent(schema interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": schema},
+	}
+}
+
+// messageRef returns a reference to the schema of a message, adding it and
+// the messages it uses to the components
+func (o *openAPI) messageRef(message protoreflect.MessageDescriptor) map[string]interface{} {
+	name := string(message.Name())
+	ref := map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	if _, ok := o.schemas[name]; ok {
+		return ref
+	}
+	// reserve the name first, messages may refer to themselves
+	o.schemas[name] = nil
+
+	properties := make(map[string]interface{})
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		schema := o.fieldSchema(field)
+		if field.IsList() {
+			schema = map[string]interface{}{"type": "array", "items": schema}
+		}
+		properties[field.JSONName()] = schema
+	}
+	o.schemas[name] = map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	return ref
+}
+
+// fieldSchema is the schema of one value of a field
+func (o *openAPI) fieldSchema(field protoreflect.FieldDescriptor) map[string]interface{} {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		var names []string
+		values := field.Enum().Values()
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		sort.Strings(names)
+		return map[string]interface{}{"type": "string", "enum": names}
+	case protoreflect.MessageKind:
+		return o.messageRef(field.Message())
+	}
+	return map[string]interface{}{"type": "string"}
+}
+
+// openAPIHandler serves the document, built once
+func openAPIHandler() (http.Handler, error) {
+	document, err := openAPIDocument()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error encoding the OpenAPI document: %v", err)
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}), nil
+}
+
+// explorerHandler serves the API explorer page
+func explorerHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(explorerPage))
+	})
+}
+
+// explorerPage lists the operations of /openapi.json and sends requests to
+// them, with no dependencies so it works offline
+const explorerPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>WordSearcher API explorer</title>
+<style>
+  body { font-family: sans-serif; margin: 0; display: flex; height: 100vh; }
+  nav { width: 22em; overflow-y: auto; border-right: 1px solid #ccc; padding: 0.5em; }
+  nav h3 { margin: 0.8em 0 0.2em; text-transform: capitalize; }
+  nav a { display: block; padding: 0.15em 0.3em; color: #024; text-decoration: none; font-size: 0.9em; }
+  nav a:hover, nav a.active { background: #e6eef8; }
+  main { flex: 1; overflow-y: auto; padding: 1em 2em; }
+  .method { display: inline-block; width: 4.5em; font-weight: bold; }
+  label { display: block; margin-top: 0.5em; font-size: 0.9em; }
+  input, textarea { width: 100%; box-sizing: border-box; font-family: monospace; }
+  textarea { height: 10em; }
+  pre { background: #f6f6f6; padding: 0.8em; overflow-x: auto; }
+</style>
+</head>
+<body>
+<nav id="operations"></nav>
+<main id="operation"><h2>WordSearcher API</h2><p>Pick an operation. The document is at <a href="/openapi.json">/openapi.json</a>.</p></main>
+<script>
+let spec;
+const el = (tag, props, ...children) => {
+  const node = Object.assign(document.createElement(tag), props || {});
+  children.forEach(c => node.append(c));
+  return node;
+};
+
+fetch('/openapi.json').then(r => r.json()).then(doc => {
+  spec = doc;
+  const groups = {};
+  Object.entries(doc.paths).forEach(([path, item]) => {
+    Object.entries(item).forEach(([method, op]) => {
+      (groups[op.tags[0]] = groups[op.tags[0]] || []).push({path, method, op});
+    });
+  });
+  const nav = document.getElementById('operations');
+  nav.append(el('label', {}, 'Bearer token', el('input', {id: 'token', placeholder: 'from /v1/login'})));
+  Object.keys(groups).sort().forEach(tag => {
+    nav.append(el('h3', {textContent: tag}));
+    groups[tag].forEach(entry => {
+      const link = el('a', {href: '#' + entry.op.operationId},
+        el('span', {className: 'method', textContent: entry.method.toUpperCase()}), entry.op.operationId);
+      link.onclick = () => { document.querySelectorAll('nav a').forEach(a => a.classList.remove('active')); link.classList.add('active'); show(entry); };
+      nav.append(link);
+    });
+  });
+});
+
+function show({path, method, op}) {
+  const main = document.getElementById('operation');
+  main.innerHTML = '';
+  main.append(el('h2', {textContent: op.operationId}), el('p', {textContent: method.toUpperCase() + ' ' + path}));
+  if (op.description) main.append(el('p', {textContent: op.description}));
+  const inputs = {};
+  (op.parameters || []).forEach(p => {
+    inputs[p.name] = el('input', {placeholder: p.schema.type + (p.schema.format ? ' ' + p.schema.format : '')});
+    main.append(el('label', {}, p.name + (p.in === 'path' ? ' (path)' : ''), inputs[p.name]));
+  });
+  let body;
+  if (op.requestBody) {
+    const ref = op.requestBody.content['application/json'].schema.$ref.split('/').pop();
+    body = el('textarea', {value: JSON.stringify(example(ref, 0), null, 2)});
+    main.append(el('label', {}, 'Body (' + ref + ')', body));
+  }
+  const out = el('pre');
+  main.append(el('p', {}, el('button', {textContent: 'Send', onclick: () => send()})), out);
+
+  function send() {
+    let url = path;
+    const query = new URLSearchParams();
+    (op.parameters || []).forEach(p => {
+      const value = inputs[p.name].value;
+      if (p.in === 'path') url = url.replace('{' + p.name + '}', encodeURIComponent(value));
+      else if (value !== '') query.append(p.name, value);
+    });
+    if (query.toString()) url += '?' + query;
+    const headers = {'Content-Type': 'application/json'};
+    const token = document.getElementById('token').value.trim();
+    if (token) headers.Authorization = 'Bearer ' + token;
+    out.textContent = method.toUpperCase() + ' ' + url + ' ...';
+    fetch(url, {method: method.toUpperCase(), headers, body: body ? body.value : undefined})
+      .then(r => r.text().then(text => {
+        let shown = text;
+        try { shown = JSON.stringify(JSON.parse(text), null, 2); } catch (e) {}
+        out.textContent = r.status + ' ' + r.statusText + '\n\n' + shown;
+      }))
+      .catch(e => { out.textContent = String(e); });
+  }
+}
+
+// example fills a schema with empty values, one level of nested messages deep
+function example(name, depth) {
+  const schema = spec.components.schemas[name];
+  const value = {};
+  Object.entries(schema.properties || {}).forEach(([field, s]) => {
+    const item = s.type === 'array' ? s.items : s;
+    let v = item.$ref ? (depth < 1 ? example(item.$ref.split('/').pop(), depth + 1) : {}) :
+      item.type === 'integer' || item.type === 'number' ? 0 : item.type === 'boolean' ? false : '';
+    value[field] = s.type === 'array' ? [] : v;
+  });
+  return value;
+}
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestOpenAPIDocument(t *testing.T) {
+	document, err := openAPIDocument()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := json.Marshal(document); err != nil {
+		t.Fatalf("the document does not encode: %v", err)
+	}
+
+	service := wordsearcher.File_wspb_ws_proto.Services().ByName("WordsearcherService")
+	paths := document["paths"].(map[string]interface{})
+	for _, route := range restRoutes {
+		method := service.Methods().ByName(protoreflect.Name(route.rpc))
+		if method == nil {
+			t.Errorf("%s %s: no %s RPC", route.method, route.pattern, route.rpc)
+			continue
+		}
+		operation, ok := paths[route.pattern].(map[string]interface{})[strings.ToLower(route.method)].(map[string]interface{})
+		if !ok {
+			t.Errorf("%s %s: no operation", route.method, route.pattern)
+			continue
+		}
+		if operation["operationId"] != route.rpc {
+			t.Errorf("%s %s: operation %v, want %s", route.method, route.pattern, operation["operationId"], route.rpc)
+		}
+
+		// every path parameter is a scalar field of the input message
+		for _, segment := range strings.Split(route.pattern, "/") {
+			if !strings.HasPrefix(segment, "{") {
+				continue
+			}
+			message := method.Input()
+			names := strings.Split(strings.Trim(segment, "{}"), ".")
+			for i, name := range names {
+				field := message.Fields().ByName(protoreflect.Name(name))
+				if field == nil {
+					t.Errorf("%s %s: %s is not a field of %s", route.method, route.pattern, segment, message.FullName())
+					break
+				}
+				if i < len(names)-1 {
+					message = field.Message()
+				} else if field.Kind() == protoreflect.MessageKind || field.IsList() {
+					t.Errorf("%s %s: %s is not a scalar field", route.method, route.pattern, segment)
+				}
+			}
+		}
+	}
+}
+
+func TestOpenAPIDocumentBadRoutes(t *testing.T) {
+	routes := restRoutes
+	defer func() { restRoutes = routes }()
+
+	tests := []struct {
+		name  string
+		route restRoute
+	}{
+		{"a misspelled RPC", restRoute{"GET", "/v1/verse/{book}/{chapter}", "Verses", "", nil}},
+		{"an unknown path parameter", restRoute{"GET", "/v1/verses/{bok}/{chapter}", "Verse", "", nil}},
+		{"an unknown nested path parameter", restRoute{"PUT", "/v1/annotations/{annotation.uuid}", "UpdateAnnotation", "annotation", nil}},
+		{"a message path parameter", restRoute{"PUT", "/v1/annotations/{annotation}", "UpdateAnnotation", "annotation", nil}},
+		{"an unknown body field", restRoute{"POST", "/v1/annotations", "CreateAnnotation", "note", nil}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			restRoutes = append([]restRoute{test.route}, routes...)
+			if _, err := openAPIDocument(); err == nil {
+				t.Errorf("built a document with the route %+v", test.route)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Pericope struct for the pericope collection, a section heading of a
+// translation and the verse it starts at (imported with ws_import pericopes)
+type Pericope struct {
+	ID          primitive.ObjectID `bson:"id"`
+	Translation string             `bson:"translation"`
+	Book        int32              `bson:"book"`
+	Chapter     int32              `bson:"chapter"`
+	Verse       int32              `bson:"verse"`
+	Title       string             `bson:"title"`
+}
+
+// translationFilter matches documents of a translation, blank matching the
+// documents loaded before translations, which have no translation field
+func translationFilter(code string) interface{} {
+	if code == "" {
+		return bson.M{"$in": bson.A{"", nil}}
+	}
+	return code
+}
+
+// attachHeadings sets the section headings on the verses they start at, so
+// clients get the headings interleaved with the text
+func attachHeadings(ctx context.Context, translation string, verses []*wordsearcher.Verse) error {
+	if len(verses) == 0 {
+		return nil
+	}
+
+	// find the headings of the books in the response
+	firstBook, lastBook := verses[0].GetBook(), verses[0].GetBook()
+	for _, verse := range verses {
+		if verse.GetBook() < firstBook {
+			firstBook = verse.GetBook()
+		}
+		if verse.GetBook() > lastBook {
+			lastBook = verse.GetBook()
+		}
+	}
+	pericopeCollection := db.Database(config.Database).Collection(config.Collections.Pericope)
+	filter := bson.M{
+		"translation": translationFilter(translation),
+		"book": bson.M{
+			"$gte": firstBook,
+			"$lte": lastBook,
+		},
+	}
+	var pericopes []*Pericope
+	pericopeCursor, err := pericopeCollection.Find(ctx, filter)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error finding the section headings: %v", err)
+	}
+	if cursorErr := pericopeCursor.All(ctx, &pericopes); cursorErr != nil {
+		return status.Errorf(codes.Internal, "Error decoding the cursor into section headings: %v", cursorErr)
+	}
+
+	headings := make(map[[3]int32][]string)
+	for _, pericope := range pericopes {
+		key := [3]int32{pericope.Book, pericope.Chapter, pericope.Verse}
+		headings[key] = append(headings[key], pericope.Title)
+	}
+	for _, verse := range verses {
+		verse.Headings = headings[[3]int32{verse.GetBook(), verse.GetChapter(), verse.GetVerse()}]
+	}
+	return nil
+}
+
+func (s server) Pericopes(ctx context.Context, request *wordsearcher.PericopesRequest) (*wordsearcher.PericopesResponse, error) {
+	// Functionality
+	// - Lists the sections of a book in order, each running until the next section starts
+	//   so the client can navigate by story rather than chapter.
+	//
+	// **Error Handling
+	// - If the book is not in the canon return out of range error
+
+	book, ok := wsbible.BookByNumber(request.GetBook())
+	if !ok {
+		return nil, status.Errorf(codes.OutOfRange, "The book must be between 1 and %d. Invalid: %v", len(wsbible.Books), request.GetBook())
+	}
+
+	pericopeCollection := db.Database(config.Database).Collection(config.Collections.Pericope)
+	filter := bson.M{
+		"translation": translationFilter(request.GetTranslation()),
+		"book":        book.Number,
+	}
+	var pericopes []*Pericope
+	pericopeCursor, err := pericopeCollection.Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "chapter", Value: 1}, {Key: "verse", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Error finding the pericopes: %v", err)
+	}
+	if cursorErr := pericopeCursor.All(ctx, &pericopes); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into pericopes: %v", cursorErr)
+	}
+
+	// the end of a section is the verse before the next one, which needs the chapter lengths
+	lengths, err := chapterLengths(ctx, book.Number)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error counting the verses of %s: %v", book.Name, err)
+	}
+
+	return &wordsearcher.PericopesResponse{
+		Pericopes: pericopeSections(book, pericopes, lengths),
+	}, nil
+}
+
+// pericopeSections turns the headings of a book, in order, into sections
+// running until the next heading. Headings stacked on the same verse, like a
+// psalm title and a section heading, are one section with their titles joined.
+func pericopeSections(book wsbible.Book, pericopes []*Pericope, lengths map[int32]int32) []*wordsearcher.Pericope {
+	var sections []*wordsearcher.Pericope
+	for _, pericope := range pericopes {
+		if last := len(sections) - 1; last >= 0 &&
+			sections[last].ChapterStart == pericope.Chapter && sections[last].VerseStart == pericope.Verse {
+			sections[last].Title += ": " + pericope.Title
+			continue
+		}
+		sections = append(sections, &wordsearcher.Pericope{
+			Title:        pericope.Title,
+			Book:         book.Number,
+			ChapterStart: pericope.Chapter,
+			VerseStart:   pericope.Verse,
+		})
+	}
+
+	// the end of a section is the verse before the next one, the last runs to the end of the book
+	for i, section := range sections {
+		section.ChapterEnd, section.VerseEnd = book.Chapters, lengths[book.Chapters]
+		if i+1 < len(sections) {
+			next := sections[i+1]
+			section.ChapterEnd, section.VerseEnd = next.ChapterStart, next.VerseStart-1
+			if section.VerseEnd < 1 {
+				section.ChapterEnd--
+				section.VerseEnd = lengths[section.ChapterEnd]
+			}
+		}
+		passage := wsbible.Reference{
+			Book:       book.Number,
+			Chapter:    section.ChapterStart,
+			Verse:      section.VerseStart,
+			EndBook:    book.Number,
+			EndChapter: section.ChapterEnd,
+			EndVerse:   section.VerseEnd,
+		}
+		section.Reference = passage.String()
+	}
+	return sections
+}
+
+// chapterLengths returns the number of the last verse of each chapter of the book
+func chapterLengths(ctx context.Context, book int32) (map[int32]int32, error) {
+	verseCollection := db.Database(config.Database).Collection(config.Collections.Verse)
+	lengthCursor, err := verseCollection.Aggregate(ctx, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{"book": book}}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id":    "$chapter",
+			"verses": bson.M{"$max": "$verse"},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var chapters []struct {
+		Chapter int32 `bson:"_id"`
+		Verses  int32 `bson:"verses"`
+	}
+	if err := lengthCursor.All(ctx, &chapters); err != nil {
+		return nil, err
+	}
+
+	lengths := make(map[int32]int32)
+	for _, chapter := range chapters {
+		lengths[chapter.Chapter] = chapter.Verses
+	}
+	return lengths, nil
+}
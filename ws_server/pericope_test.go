@@ -0,0 +1,73 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+)
+
+func TestPericopeSections(t *testing.T) {
+	psalms, _ := wsbible.BookByNumber(19)
+	lengths := map[int32]int32{1: 6, 2: 12, 150: 6}
+
+	tests := []struct {
+		name      string
+		headings  []*Pericope
+		titles    []string
+		ends      [][2]int32
+		reference string // of the first section
+	}{
+		{
+			name: "one heading a chapter",
+			headings: []*Pericope{
+				{Chapter: 1, Verse: 1, Title: "The Way of the Righteous"},
+				{Chapter: 2, Verse: 1, Title: "The Reign of the LORD's Anointed"},
+			},
+			titles:    []string{"The Way of the Righteous", "The Reign of the LORD's Anointed"},
+			ends:      [][2]int32{{1, 6}, {150, 6}},
+			reference: "Psalms 1:1-6",
+		},
+		{
+			name: "stacked headings at the start",
+			headings: []*Pericope{
+				{Chapter: 1, Verse: 1, Title: "Book One"},
+				{Chapter: 1, Verse: 1, Title: "The Way of the Righteous"},
+				{Chapter: 2, Verse: 1, Title: "The Reign of the LORD's Anointed"},
+			},
+			titles:    []string{"Book One: The Way of the Righteous", "The Reign of the LORD's Anointed"},
+			ends:      [][2]int32{{1, 6}, {150, 6}},
+			reference: "Psalms 1:1-6",
+		},
+		{
+			name: "stacked headings mid chapter",
+			headings: []*Pericope{
+				{Chapter: 1, Verse: 1, Title: "The Way of the Righteous"},
+				{Chapter: 2, Verse: 7, Title: "A Decree"},
+				{Chapter: 2, Verse: 7, Title: "The Son"},
+			},
+			titles:    []string{"The Way of the Righteous", "A Decree: The Son"},
+			ends:      [][2]int32{{2, 6}, {150, 6}},
+			reference: "Psalms 1:1-2:6",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sections := pericopeSections(psalms, test.headings, lengths)
+			if len(sections) != len(test.titles) {
+				t.Fatalf("got %d sections, want %d", len(sections), len(test.titles))
+			}
+			for i, section := range sections {
+				if section.GetTitle() != test.titles[i] {
+					t.Errorf("section %d title %q, want %q", i, section.GetTitle(), test.titles[i])
+				}
+				end := [2]int32{section.GetChapterEnd(), section.GetVerseEnd()}
+				if end != test.ends[i] {
+					t.Errorf("section %d ends at %v, want %v", i, end, test.ends[i])
+				}
+			}
+			if sections[0].GetReference() != test.reference {
+				t.Errorf("first section %q, want %q", sections[0].GetReference(), test.reference)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s server) ImportPlan(ctx context.Context, request *wordsearcher.ImportPlanRequest) (*wordsearcher.ImportPlanResponse, error) {
+	// Functionality
+	// - Admin: loads a reading plan from CSV or JSON, replacing the tracks of the plans
+	//   in the file. Every reading is parsed as a reference first and the invalid ones
+	//   are returned with their day, nothing is saved unless all are valid.
+	// - dry_run validates without saving.
+	//
+	// **Error Handling
+	// - If the data is not a plan in the format return invalid argument error
+
+	tracks, err := wsbible.ReadPlan(bytes.NewReader(request.GetData()), request.GetFormat(), request.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error reading the plan: %v", err)
+	}
+
+	response := &wordsearcher.ImportPlanResponse{}
+	for _, track := range tracks {
+		response.BiblePlan = append(response.BiblePlan, &wordsearcher.BiblePlan{
+			Name:   track.Name,
+			Number: track.Number,
+			Days:   track.Days,
+		})
+	}
+	for _, planErr := range wsbible.ValidatePlan(tracks) {
+		response.Errors = append(response.Errors, &wordsearcher.PlanError{
+			Day:     planErr.Day,
+			Track:   planErr.Track,
+			Reading: planErr.Reading,
+			Message: planErr.Err.Error(),
+		})
+	}
+	if len(response.Errors) > 0 || request.GetDryRun() {
+		return response, nil
+	}
+
+	if err := savePlan(ctx, tracks); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error saving the plan: %v", err)
+	}
+	response.Saved = true
+	return response, nil
+}
+
+// savePlan replaces the tracks of each plan named in tracks
+func savePlan(ctx context.Context, tracks []wsbible.PlanTrack) error {
+	planCollection := db.Database(config.Database).Collection(config.Collections.ReadingPlan)
+
+	var names bson.A
+	seen := make(map[string]bool)
+	var plans []interface{}
+	for _, track := range tracks {
+		if !seen[track.Name] {
+			seen[track.Name] = true
+			names = append(names, track.Name)
+		}
+		plans = append(plans, bson.M{
+			"name":   track.Name,
+			"number": track.Number,
+			"days":   track.Days,
+		})
+	}
+	if len(plans) == 0 {
+		return nil
+	}
+
+	if _, err := planCollection.DeleteMany(ctx, bson.M{"name": bson.M{"$in": names}}); err != nil {
+		return err
+	}
+	_, err := planCollection.InsertMany(ctx, plans)
+	return err
+}
+
+func (s server) ExportPlan(ctx context.Context, request *wordsearcher.ExportPlanRequest) (*wordsearcher.ExportPlanResponse, error) {
+	// Functionality
+	// - Admin: writes the tracks of a reading plan as CSV or JSON, the formats ImportPlan reads.
+	//
+	// **Error Handling
+	// - If the plan does not exist return not found error
+	// - If the format is unknown return invalid argument error
+
+	planCollection := db.Database(config.Database).Collection(config.Collections.ReadingPlan)
+	var biblePlans []BiblePlan
+	planCursor, err := planCollection.Find(ctx, bson.M{"name": request.GetName()}, options.Find().SetSort(bson.M{"number": 1}))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the bible plan: %v", err)
+	}
+	if cursorErr := planCursor.All(ctx, &biblePlans); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into plans: %v", cursorErr)
+	}
+	if len(biblePlans) == 0 {
+		return nil, status.Errorf(codes.NotFound, "There is no bible plan named %q", request.GetName())
+	}
+
+	var tracks []wsbible.PlanTrack
+	for _, biblePlan := range biblePlans {
+		tracks = append(tracks, wsbible.PlanTrack{
+			Name:   biblePlan.Name,
+			Number: biblePlan.Number,
+			Days:   biblePlan.Days,
+		})
+	}
+	var data bytes.Buffer
+	if err := wsbible.WritePlan(&data, request.GetFormat(), tracks); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error writing the plan: %v", err)
+	}
+
+	return &wordsearcher.ExportPlanResponse{
+		Data: data.Bytes(),
+	}, nil
+}
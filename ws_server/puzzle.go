@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Puzzle defaults and limits
+const (
+	defaultPuzzleSize      = 12
+	minPuzzleSize          = 5
+	maxPuzzleSize          = 30
+	defaultPuzzleMinLength = 4
+	defaultPuzzleWords     = 12
+	maxPuzzleVerses        = 500
+)
+
+func (s server) WordSearchPuzzle(ctx context.Context, request *wordsearcher.WordSearchPuzzleRequest) (*wordsearcher.WordSearchPuzzleResponse, error) {
+	// Functionality
+	// - Builds a word search from a passage or CustomRange: the significant words
+	//   (no stopwords, at least min_length letters), the most used first, are hidden
+	//   in a size by size grid in the chosen directions.
+	// - The seed makes the puzzle reproducible, a new one is picked and returned
+	//   when it is 0.
+	//
+	// **Error Handling
+	// - If not exactly one of reference and custom_range is given return invalid argument error
+	// - If the size is not 5 to 30 or a direction is unknown return invalid argument error
+	// - If the passage is longer than 500 verses return out of range error
+	// - If the passage has no words to use return not found error
+
+	if (request.GetReference() == "") == (request.GetCustomRange() == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "Give either a reference or a custom_range")
+	}
+	size := int(request.GetSize())
+	if size == 0 {
+		size = defaultPuzzleSize
+	}
+	if size < minPuzzleSize || size > maxPuzzleSize {
+		return nil, status.Errorf(codes.InvalidArgument, "The size must be between %d and %d. Invalid: %v", minPuzzleSize, maxPuzzleSize, request.GetSize())
+	}
+	names := request.GetDirections()
+	if len(names) == 0 {
+		names = []string{"across", "down", "diagonal"}
+	}
+	var directions [][2]int
+	for _, name := range names {
+		steps, ok := wsbible.PuzzleDirections[strings.ToLower(name)]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "The directions must be across, down or diagonal. Invalid: %v", name)
+		}
+		directions = append(directions, steps...)
+	}
+	minLength := int(request.GetMinLength())
+	if minLength <= 0 {
+		minLength = defaultPuzzleMinLength
+	}
+	maxWords := int(request.GetMaxWords())
+	if maxWords <= 0 {
+		maxWords = defaultPuzzleWords
+	}
+	seed := request.GetSeed()
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	// the verses of the passage
+	var verses []*wordsearcher.Verse
+	var reference string
+	if request.GetReference() != "" {
+		passage, err := wsbible.ParseReference(request.GetReference())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid reference %q: %v", request.GetReference(), err)
+		}
+		if verses, err = s.passageVerses(ctx, passage, request.GetTranslation()); err != nil {
+			return nil, err
+		}
+		reference = passage.String()
+	} else {
+		var err error
+		if verses, err = s.rangeVerses(ctx, request.GetCustomRange(), request.GetTranslation()); err != nil {
+			return nil, err
+		}
+		reference = request.GetCustomRange()
+	}
+	if len(verses) > maxPuzzleVerses {
+		return nil, status.Errorf(codes.OutOfRange, "%s has %d verses, a puzzle takes at most %d", reference, len(verses), maxPuzzleVerses)
+	}
+
+	var texts []string
+	for _, verse := range verses {
+		texts = append(texts, verse.GetText())
+	}
+	words := wsbible.PuzzleWords(strings.Join(texts, " "), minLength)
+	if len(words) == 0 {
+		return nil, status.Errorf(codes.NotFound, "%s has no words of %d or more letters for a puzzle", reference, minLength)
+	}
+	if len(words) > maxWords {
+		words = words[:maxWords]
+	}
+
+	grid, placed, skipped, err := wsbible.GeneratePuzzle(words, size, directions, request.GetBackwards(), seed)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error generating the puzzle: %v", err)
+	}
+
+	// build the protocol buffer response
+	response := &wordsearcher.WordSearchPuzzleResponse{
+		Skipped:   skipped,
+		Reference: reference,
+		Verses:    verses,
+		Seed:      seed,
+	}
+	for _, row := range grid {
+		response.Grid = append(response.Grid, string(row))
+	}
+	for _, p := range placed {
+		response.Words = append(response.Words, p.Word)
+		response.Solutions = append(response.Solutions, &wordsearcher.PuzzleWord{
+			Word:      p.Word,
+			Row:       int32(p.Row),
+			Column:    int32(p.Column),
+			EndRow:    int32(p.EndRow),
+			EndColumn: int32(p.EndColumn),
+		})
+	}
+	sort.Strings(response.Words)
+	return response, nil
+}
+
+// rangeVerses returns the verses of a CustomRange in reading order
+func (s server) rangeVerses(ctx context.Context, name, translation string) ([]*wordsearcher.Verse, error) {
+	scope, err := s.generateScope(ctx, &wordsearcher.PlanTrackScope{CustomRange: name})
+	if err != nil {
+		return nil, err
+	}
+	books := bson.A{}
+	order := make(map[int32]int)
+	for i, number := range scope.books {
+		books = append(books, number)
+		order[number] = i
+	}
+
+	verseCollection := db.Database(config.Database).Collection(config.Collections.Verse)
+	filter := bson.M{
+		"book":        bson.M{"$in": books},
+		"translation": translationFilter(translation),
+	}
+	var found []*Verse
+	verseCursor, err := verseCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "chapter", Value: 1}, {Key: "verse", Value: 1}}))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the verses of the custom range: %v", err)
+	}
+	if cursorErr := verseCursor.All(ctx, &found); cursorErr != nil {
+		return nil, status.Errorf(codes.Internal, "Error decoding the cursor into verses: %v", cursorErr)
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		return order[found[i].Book] < order[found[j].Book]
+	})
+
+	var verses []*wordsearcher.Verse
+	for _, verse := range found {
+		if scope.includes(verse.Book, verse.Chapter) {
+			verses = append(verses, verse.proto())
+		}
+	}
+	return verses, nil
+}
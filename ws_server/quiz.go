@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Quiz defaults and limits
+const (
+	defaultQuizQuestions = 10
+	maxQuizQuestions     = 50
+	defaultQuizChoices   = 4
+	minQuizChoices       = 2
+	maxQuizChoices       = 6
+	quizBlankMinLength   = 4
+)
+
+// quizTypes are the kinds of quiz question
+var quizTypes = []string{"fill_blank", "which_book"}
+
+// quizQuestion is a generated question with its answer, which only CheckQuiz sees
+type quizQuestion struct {
+	question *wordsearcher.QuizQuestion
+	answer   string
+	book     int32 // the answer of a which_book question
+	verse    string
+}
+
+func (s server) Quiz(ctx context.Context, request *wordsearcher.QuizRequest) (*wordsearcher.QuizResponse, error) {
+	// Functionality
+	// - Picks verses of the scope and asks, for each, to fill in a blanked word or
+	//   which book the verse is from, with multiple choices. Wrong books come from
+	//   the same genre and wrong words from the other verses of the quiz.
+	// - The seed makes the quiz reproducible, a new one is picked and returned
+	//   when it is 0.
+	//
+	// **Error Handling
+	// - If the number of questions or choices, or a type, is invalid return invalid argument error
+	// - If the scope has no verses return not found error
+
+	seed := request.GetSeed()
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	questions, err := s.generateQuiz(ctx, request, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &wordsearcher.QuizResponse{
+		Seed: seed,
+	}
+	for _, q := range questions {
+		response.Questions = append(response.Questions, q.question)
+	}
+	return response, nil
+}
+
+func (s server) CheckQuiz(ctx context.Context, request *wordsearcher.CheckQuizRequest) (*wordsearcher.CheckQuizResponse, error) {
+	// Functionality
+	// - Scores the answers to a quiz, made again from its request and seed. A
+	//   book may be answered by any name BookByName knows, and a word with a typo.
+	//
+	// **Error Handling
+	// - If the quiz has no seed return invalid argument error
+	// - If there are more answers than questions return invalid argument error
+
+	if request.GetQuiz().GetSeed() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Check a quiz with the seed the Quiz RPC returned")
+	}
+	questions, err := s.generateQuiz(ctx, request.GetQuiz(), request.GetQuiz().GetSeed())
+	if err != nil {
+		return nil, err
+	}
+	if len(request.GetAnswers()) > len(questions) {
+		return nil, status.Errorf(codes.InvalidArgument, "There are %d answers for %d questions", len(request.GetAnswers()), len(questions))
+	}
+
+	response := &wordsearcher.CheckQuizResponse{
+		Total: int32(len(questions)),
+	}
+	for i, q := range questions {
+		var answer string
+		if i < len(request.GetAnswers()) {
+			answer = strings.TrimSpace(request.GetAnswers()[i])
+		}
+		var correct bool
+		if q.book != 0 {
+			book, ok := wsbible.BookByName(answer)
+			correct = ok && book.Number == q.book
+		} else {
+			correct = answer != "" && wsbible.MatchesWord(answer, q.answer)
+		}
+		if correct {
+			response.Correct++
+		}
+		response.Results = append(response.Results, &wordsearcher.QuizResult{
+			Number:        q.question.GetNumber(),
+			Correct:       correct,
+			Answer:        answer,
+			CorrectAnswer: q.answer,
+			Reference:     q.verse,
+		})
+	}
+	if response.Total > 0 {
+		response.Score = float64(response.Correct) / float64(response.Total)
+	}
+	return response, nil
+}
+
+// generateQuiz makes the questions of a quiz. Everything is drawn from one
+// random source seeded by seed, in a fixed order, so the same request and
+// seed give the same quiz.
+func (s server) generateQuiz(ctx context.Context, request *wordsearcher.QuizRequest, seed int64) ([]quizQuestion, error) {
+	count := int(request.GetQuestions())
+	if count == 0 {
+		count = defaultQuizQuestions
+	}
+	if count < 1 || count > maxQuizQuestions {
+		return nil, status.Errorf(codes.InvalidArgument, "The questions must be between 1 and %d. Invalid: %v", maxQuizQuestions, request.GetQuestions())
+	}
+	choices := int(request.GetChoices())
+	if choices == 0 {
+		choices = defaultQuizChoices
+	}
+	if choices < minQuizChoices || choices > maxQuizChoices {
+		return nil, status.Errorf(codes.InvalidArgument, "The choices must be between %d and %d. Invalid: %v", minQuizChoices, maxQuizChoices, request.GetChoices())
+	}
+	types := request.GetTypes()
+	if len(types) == 0 {
+		types = quizTypes
+	}
+	for _, t := range types {
+		if t != "fill_blank" && t != "which_book" {
+			return nil, status.Errorf(codes.InvalidArgument, "The types must be fill_blank or which_book. Invalid: %v", t)
+		}
+	}
+
+	// the verses of the scope, the whole Bible by default
+	var scope planScope
+	if len(request.GetBooks()) == 0 && request.GetTestament() == "" && request.GetCustomRange() == "" {
+		for _, book := range wsbible.Books {
+			scope.books = append(scope.books, book.Number)
+		}
+	} else {
+		var err error
+		scope, err = s.generateScope(ctx, &wordsearcher.PlanTrackScope{
+			Books:       request.GetBooks(),
+			Testament:   request.GetTestament(),
+			CustomRange: request.GetCustomRange(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	units, _, err := planUnits(ctx, scope, "verses", false, request.GetTranslation())
+	if err != nil {
+		return nil, err
+	}
+	if len(units) == 0 {
+		return nil, status.Errorf(codes.NotFound, "The scope has no verses for a quiz")
+	}
+
+	random := rand.New(rand.NewSource(seed))
+	var questions []quizQuestion
+	var texts []string
+	for _, i := range random.Perm(len(units)) {
+		if len(questions) == count {
+			break
+		}
+		unit := units[i]
+		res, err := s.Verse(ctx, &wordsearcher.VerseRequest{
+			Book:        unit.Book,
+			Chapter:     unit.Chapter,
+			VerseStart:  unit.Verse,
+			VerseEnd:    unit.Verse,
+			Translation: request.GetTranslation(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(res.GetVerses()) == 0 || strings.TrimSpace(res.GetVerses()[0].GetText()) == "" {
+			continue
+		}
+		text := strings.TrimSpace(res.GetVerses()[0].GetText())
+		book, _ := wsbible.BookByNumber(unit.Book)
+		verse := fmt.Sprintf("%s %d:%d", book.Name, unit.Chapter, unit.Verse)
+
+		q := quizQuestion{
+			question: &wordsearcher.QuizQuestion{Number: int32(len(questions) + 1)},
+			verse:    verse,
+		}
+		questionType := types[random.Intn(len(types))]
+		if questionType == "fill_blank" {
+			prompt, answer, ok := wsbible.BlankVerse(text, quizBlankMinLength, random)
+			if !ok {
+				// a verse too short to blank can still be a which book question
+				if len(types) == 1 {
+					continue
+				}
+				questionType = "which_book"
+			} else {
+				q.question.Prompt, q.answer = prompt, answer
+				q.question.Reference = verse
+			}
+		}
+		if questionType == "which_book" {
+			q.question.Prompt = text
+			q.answer, q.book = book.Name, book.Number
+			var names []string
+			for _, number := range wsbible.BookDistractors(book.Number, choices-1, random) {
+				other, _ := wsbible.BookByNumber(number)
+				names = append(names, other.Name)
+			}
+			q.question.Choices = withAnswer(names, book.Name, random)
+		}
+		q.question.Type = questionType
+		questions = append(questions, q)
+		texts = append(texts, text)
+	}
+
+	// the wrong words come from all the verses of the quiz
+	pool := wsbible.PuzzleWords(strings.Join(texts, " "), quizBlankMinLength)
+	for _, q := range questions {
+		if q.book == 0 {
+			q.question.Choices = withAnswer(wsbible.WordDistractors(q.answer, pool, choices-1, random), q.answer, random)
+		}
+	}
+	return questions, nil
+}
+
+// withAnswer adds the answer to the wrong choices at a random place
+func withAnswer(wrong []string, answer string, random *rand.Rand) []string {
+	at := random.Intn(len(wrong) + 1)
+	choices := append([]string{}, wrong[:at]...)
+	choices = append(choices, answer)
+	return append(choices, wrong[at:]...)
+}
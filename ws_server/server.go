@@ -2,7 +2,10 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	"github.com/jwjones2/wordsearcher-server/wsconfig"
 	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -10,11 +13,17 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"syscall"
 	"time"
 )
 
@@ -23,14 +32,14 @@ var db *mongo.Client
 var collection *mongo.Collection
 var mongoCtx context.Context
 
+// config is the server configuration, loaded in main
+var config *wsconfig.Config
+
 // empty struct for the gRpc server
 type server struct {
 	wordsearcher.UnimplementedWordsearcherServiceServer
 }
 
-/* TODO - User
- */
-
 // Verse struct for the Database items to return
 type Verse struct {
 	ID       primitive.ObjectID `bson:"id"`
@@ -40,6 +49,45 @@ type Verse struct {
 	Verse    int32              `bson:"verse"`
 	Text     string             `bson:"text"`
 	Keywords string             `bson:"keywords"`
+	Words    []Word             `bson:"words,omitempty"`
+	Spans    []wsbible.Span     `bson:"spans,omitempty"`
+
+	// search fields, set for verses loaded per translation
+	Translation string `bson:"translation,omitempty"`
+	TextFolded  string `bson:"text_folded,omitempty"`
+	TextWOC     string `bson:"text_woc,omitempty"` // words of Christ only
+}
+
+// Word struct for the optional per word tagging of a verse, as in KJV+ datasets
+type Word struct {
+	Text    string   `bson:"text"`
+	Strongs []string `bson:"strongs,omitempty"`
+}
+
+// proto converts the verse into its protocol buffer message
+func (v *Verse) proto() *wordsearcher.Verse {
+	verse := &wordsearcher.Verse{
+		Book:     v.Book,
+		Chapter:  v.Chapter,
+		BookName: v.BookName,
+		Verse:    v.Verse,
+		Text:     v.Text,
+		Keywords: v.Keywords,
+	}
+	for _, word := range v.Words {
+		verse.Words = append(verse.Words, &wordsearcher.Word{
+			Text:    word.Text,
+			Strongs: word.Strongs,
+		})
+	}
+	for _, span := range v.Spans {
+		verse.Spans = append(verse.Spans, &wordsearcher.Span{
+			Type:  span.Type,
+			Start: span.Start,
+			End:   span.End,
+		})
+	}
+	return verse
 }
 
 // BiblePlan Bible plan struct to return Bible Plans
@@ -75,7 +123,7 @@ func (s server) Verse(ctx context.Context, request *wordsearcher.VerseRequest) (
 	// 		- if verse_start > verse_end return out of range error
 
 	// first get the proper collection
-	collection = db.Database("myFirstDatabase").Collection("verse")
+	collection = db.Database(config.Database).Collection(config.Collections.Verse)
 
 	// check the verse_start and verse_end variables, return errors if necessary
 	// then build the filter for the search.
@@ -107,6 +155,7 @@ func (s server) Verse(ctx context.Context, request *wordsearcher.VerseRequest) (
 			},
 		}
 	}
+	filter["translation"] = translationFilter(request.GetTranslation())
 
 	// do the Database call and store the results
 	var verses []*Verse
@@ -121,14 +170,20 @@ func (s server) Verse(ctx context.Context, request *wordsearcher.VerseRequest) (
 	// build the protocol buffer response
 	var verseResponses []*wordsearcher.Verse
 	for _, verse := range verses {
-		verseResponses = append(verseResponses, &wordsearcher.Verse{
-			Book:     verse.Book,
-			Chapter:  verse.Chapter,
-			BookName: verse.BookName,
-			Verse:    verse.Verse,
-			Text:     verse.Text,
-			Keywords: verse.Keywords,
-		})
+		verseResponses = append(verseResponses, verse.proto())
+	}
+	if err := attachHeadings(ctx, request.GetTranslation(), verseResponses); err != nil {
+		return nil, err
+	}
+	if request.GetIncludeFootnotes() {
+		if err := attachFootnotes(ctx, request.GetTranslation(), verseResponses); err != nil {
+			return nil, err
+		}
+	}
+	if request.GetIncludeAnnotations() {
+		if err := attachAnnotations(ctx, verseResponses); err != nil {
+			return nil, err
+		}
 	}
 
 	// return the results to the client
@@ -142,7 +197,7 @@ func (s server) BiblePlan(ctx context.Context, request *wordsearcher.BiblePlanRe
 	// - Finds a bible plan by name and returns.
 
 	// first get the proper collection
-	collection = db.Database("myFirstDatabase").Collection("readingplan")
+	collection = db.Database(config.Database).Collection(config.Collections.ReadingPlan)
 
 	// build filter and search
 	filter := bson.M{
@@ -174,56 +229,51 @@ func (s server) BiblePlan(ctx context.Context, request *wordsearcher.BiblePlanRe
 
 func (s server) BiblePlanDay(ctx context.Context, request *wordsearcher.BiblePlanDayRequest) (*wordsearcher.BiblePlanDayResponse, error) {
 	// Functionality
-	// - Request a specific day in the Bible plan and return
+	// - Request a specific day in the Bible plan and return the reading of each track.
+	//   Days are 1 based, day 1 is the first reading, and day 0 is today's day of
+	//   the year. Days past the end of a plan wrap around.
 	//
 	// **Error Handling
 	// - If day is less than 0 return out of range error
-
-	// first get the proper collection
-	collection = db.Database("myFirstDatabase").Collection("readingplan")
+	// - If the plan does not exist return not found error
 
 	// check the verse_start and verse_end variables, return errors if necessary
 	// then build the filter for the search.
 	if request.Day < 0 {
 		return nil, status.Errorf(codes.OutOfRange, "The Bible Plan Day must be positive. Invalid: %v", request.Day)
 	}
+	day := request.GetDay()
+	if day == 0 {
+		day = int32(time.Now().YearDay())
+	}
 
 	// Search the Database and return
-	// need to use an aggregate to be able to return the proper format
-	t := time.Now()
-	cDay := t.YearDay() - 1
-	matchStage := bson.D{
-		{"$match", bson.D{
-			{"name", "McCheyneBasedYearly"},
-		}},
-	}
-	projectStage := bson.D{
-		{"$project", bson.M{
-			"reading": bson.M{
-				"$arrayElemAt": bson.A{
-					"$days", cDay,
-				},
-			},
-		}},
-	}
-	planCursor, err := collection.Aggregate(ctx, mongo.Pipeline{matchStage, projectStage})
+	biblePlans, err := planTracks(ctx, request.GetName())
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "Could not find the bible plan day: %v", err)
+		return nil, err
 	}
 
-	// unpack the found plan days
-	var biblePlanDays []BiblePlanDay
-	if cursorErr := planCursor.All(ctx, &biblePlanDays); cursorErr != nil {
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error decoding the cursor into plans: %v", cursorErr.Error()))
+	// the reading of the day in each track, in track order
+	var readings []string
+	for _, biblePlan := range biblePlans {
+		reading := ""
+		if len(biblePlan.Days) > 0 {
+			reading = biblePlan.Days[planDayIndex(day, len(biblePlan.Days))]
+		}
+		readings = append(readings, reading)
 	}
+	first4 := make([]string, 4)
+	copy(first4, readings)
 
 	// build the protocol buffer response
 	biblePlanDayResponse := &wordsearcher.BiblePlanDay{
 		Name:     request.GetName(),
-		Reading1: biblePlanDays[0].Reading,
-		Reading2: biblePlanDays[1].Reading,
-		Reading3: biblePlanDays[2].Reading,
-		Reading4: biblePlanDays[3].Reading,
+		Reading1: first4[0],
+		Reading2: first4[1],
+		Reading3: first4[2],
+		Reading4: first4[3],
+		Readings: readings,
+		Day:      day,
 	}
 
 	return &wordsearcher.BiblePlanDayResponse{
@@ -231,6 +281,12 @@ func (s server) BiblePlanDay(ctx context.Context, request *wordsearcher.BiblePla
 	}, nil
 }
 
+// planDayIndex is the index in a plan of days readings of the 1 based day,
+// wrapping around past the end of the plan
+func planDayIndex(day int32, days int) int {
+	return int(day-1) % days
+}
+
 func (s server) Search(ctx context.Context, request *wordsearcher.SearchRequest) (*wordsearcher.VerseResponse, error) {
 	// Functionality
 	// - Takes a search term and filters (filter [type of search, any term, exact term...], location [in Scriptures],
@@ -239,9 +295,22 @@ func (s server) Search(ctx context.Context, request *wordsearcher.SearchRequest)
 	// - Searches and returns matching verses
 	//
 	// * Defaults to search everywhere, match any terms
+	// * The layer selects the footnotes instead of the verse text
+
+	switch request.GetLayer() {
+	case "", "text":
+	case "footnotes":
+		if request.GetWordsOfChrist() || request.GetFilter() == "strongs" {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"The footnotes layer cannot be searched by words of Christ or Strong's number.")
+		}
+		return searchFootnotes(ctx, request)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown search layer %s, use text or footnotes.", request.GetLayer())
+	}
 
 	// first get the proper collection
-	collection = db.Database("myFirstDatabase").Collection("verse")
+	collection = db.Database(config.Database).Collection(config.Collections.Verse)
 
 	// build the stages for the mongo Pipeline, project and sort remain the same for any kind of search
 	projectStage := bson.D{
@@ -251,6 +320,9 @@ func (s server) Search(ctx context.Context, request *wordsearcher.SearchRequest)
 			{"chapter", 1},
 			{"verse", 1},
 			{"text", 1},
+			{"words", 1},
+			{"spans", 1},
+			{"translation", 1},
 			{"score", bson.M{
 				"$meta": "searchScore",
 			}},
@@ -263,6 +335,32 @@ func (s server) Search(ctx context.Context, request *wordsearcher.SearchRequest)
 	}
 	var filterStage bson.D
 
+	// the translation decides how its text was analyzed, folding translations (Greek, Hebrew)
+	// are searched on the folded text with a folded term so unaccented queries match
+	textPath, term := "text", request.GetTerm()
+	if request.GetWordsOfChrist() {
+		// red letter search, only the words of Christ spans indexed by the importer
+		textPath = "text_woc"
+	}
+	var translationStage bson.D
+	if request.GetTranslation() != "" {
+		translation, err := findTranslation(ctx, request.GetTranslation())
+		if err != nil {
+			return nil, err
+		}
+		if wsbible.Folds(translation.Analyzer) {
+			term = wsbible.Fold(translation.Analyzer, term)
+			if textPath == "text" {
+				textPath = "text_folded"
+			}
+		}
+		translationStage = bson.D{
+			{"$match", bson.M{
+				"translation": translation.Code,
+			}},
+		}
+	}
+
 	// first check if the default case, no filter or location, is true
 	// if so, create the default search filter, else test conditions and
 	// build the filter from filter, location, and options
@@ -281,8 +379,8 @@ func (s server) Search(ctx context.Context, request *wordsearcher.SearchRequest)
 		filterStage = bson.D{
 			{"$search", bson.D{
 				{"text", bson.D{
-					{"path", "text"},
-					{"query", request.GetTerm()},
+					{"path", textPath},
+					{"query", term},
 				}},
 			}},
 		}
@@ -296,54 +394,30 @@ func (s server) Search(ctx context.Context, request *wordsearcher.SearchRequest)
 		case "exact":
 			matchDoc = bson.D{
 				{"phrase", bson.D{
-					{"path", "text"},
-					{"query", request.GetTerm()},
+					{"path", textPath},
+					{"query", term},
 					/* TODO - May need to add distance in the future "slop": <distance of words apart - 0 is default, means words right next to each other */
 				}}}
+		case "strongs": // search by original language lemma, the term is a Strong's number like H2617 or G26
+			strongs, err := wsbible.NormalizeStrongs(request.GetTerm())
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid Strong's number search: %v", err)
+			}
+			matchDoc = bson.D{
+				{"text", bson.D{
+					{"path", "words.strongs"},
+					{"query", strongs},
+				}}}
 		case "in": // ??? TODO - this is the same as default???
 		default: // all or blank, search to match any terms (Mongo default as well)
 			matchDoc = bson.D{
 				{"text", bson.D{
-					{"path", "text"},
-					{"query", request.GetTerm()},
+					{"path", textPath},
+					{"query", term},
 				}}}
 		}
 
-		switch request.GetLocation() {
-		case "nt": // only the New Testament, books > 39
-			locationDoc = bson.D{
-				{"range", bson.D{
-					{"path", "book"},
-					{"gt", 39},
-				}}}
-
-		case "ot": // only search in the Old Testament, book <= 39
-			locationDoc = bson.D{
-				{"range", bson.D{
-					{"path", "book"},
-					{"lte", 39},
-				}}}
-		case "law": // first 5 books of the Bible, Penteteuch
-			locationDoc = bson.D{
-				{"range", bson.D{
-					{"path", "book"},
-					{"lte", 5},
-				}}}
-		case "bookname": // allows a book name to be passed on Options
-			/* TODO - Need to finish this, set to match a bookname passed on GetOptions */
-			locationDoc = bson.D{
-				{"range", bson.D{
-					{"path", "book"},
-					{"gt", 39},
-				}}}
-			/* TODO - Add other searchable locations, like poetry... */
-		default: // search any matching terms
-			locationDoc = bson.D{
-				{"range", bson.D{
-					{"path", "book"},
-					{"gt", 0},
-				}}}
-		}
+		locationDoc = locationClause(request.GetLocation())
 
 		// build the filterStage from the above matchDoc and locationDoc
 		filterStage = bson.D{
@@ -360,7 +434,12 @@ func (s server) Search(ctx context.Context, request *wordsearcher.SearchRequest)
 		fmt.Println(filterStage)
 	}
 
-	verseCursor, err := collection.Aggregate(ctx, mongo.Pipeline{filterStage, projectStage, sortStage})
+	pipeline := mongo.Pipeline{filterStage}
+	if translationStage != nil {
+		pipeline = append(pipeline, translationStage)
+	}
+	pipeline = append(pipeline, projectStage, sortStage)
+	verseCursor, err := collection.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "Could not complete the verse search: %v", err)
 	}
@@ -374,14 +453,7 @@ func (s server) Search(ctx context.Context, request *wordsearcher.SearchRequest)
 	// build the protocol buffer response
 	var verseResponses []*wordsearcher.Verse
 	for _, verse := range verses {
-		verseResponses = append(verseResponses, &wordsearcher.Verse{
-			Book:     verse.Book,
-			Chapter:  verse.Chapter,
-			BookName: verse.BookName,
-			Verse:    verse.Verse,
-			Text:     verse.Text,
-			Keywords: verse.Keywords,
-		})
+		verseResponses = append(verseResponses, verse.proto())
 	}
 
 	// return the results to the client
@@ -390,6 +462,46 @@ func (s server) Search(ctx context.Context, request *wordsearcher.SearchRequest)
 	}, nil
 }
 
+// locationClause builds the Atlas Search clause limiting a search to a location
+// of the Scriptures, i.e. nt, ot or law, anything else searches every book
+func locationClause(location string) bson.D {
+	switch location {
+	case "nt": // only the New Testament, books > 39
+		return bson.D{
+			{"range", bson.D{
+				{"path", "book"},
+				{"gt", 39},
+			}}}
+
+	case "ot": // only search in the Old Testament, book <= 39
+		return bson.D{
+			{"range", bson.D{
+				{"path", "book"},
+				{"lte", 39},
+			}}}
+	case "law": // first 5 books of the Bible, Penteteuch
+		return bson.D{
+			{"range", bson.D{
+				{"path", "book"},
+				{"lte", 5},
+			}}}
+	case "bookname": // allows a book name to be passed on Options
+		/* TODO - Need to finish this, set to match a bookname passed on GetOptions */
+		return bson.D{
+			{"range", bson.D{
+				{"path", "book"},
+				{"gt", 39},
+			}}}
+		/* TODO - Add other searchable locations, like poetry... */
+	default: // search any matching terms
+		return bson.D{
+			{"range", bson.D{
+				{"path", "book"},
+				{"gt", 0},
+			}}}
+	}
+}
+
 func (s server) BookRange(ctx context.Context, request *wordsearcher.BookRangeRequest) (*wordsearcher.VerseResponse, error) {
 	// Functionality:
 	// - Query verses based on book start and book end inclusive.
@@ -400,7 +512,7 @@ func (s server) BookRange(ctx context.Context, request *wordsearcher.BookRangeRe
 	// - If book start and book end equal, returns one book
 
 	// first get the proper collection
-	collection = db.Database("myFirstDatabase").Collection("verse")
+	collection = db.Database(config.Database).Collection(config.Collections.Verse)
 
 	// validation - error handling
 	if request.GetStart() < 0 {
@@ -419,6 +531,7 @@ func (s server) BookRange(ctx context.Context, request *wordsearcher.BookRangeRe
 			"$lte": request.GetEnd(),
 		},
 	}
+	filter["translation"] = translationFilter(request.GetTranslation())
 
 	// do the Database call and store the results
 	var verses []*Verse
@@ -433,14 +546,20 @@ func (s server) BookRange(ctx context.Context, request *wordsearcher.BookRangeRe
 	// build the protocol buffer response
 	var verseResponses []*wordsearcher.Verse
 	for _, verse := range verses {
-		verseResponses = append(verseResponses, &wordsearcher.Verse{
-			Book:     verse.Book,
-			Chapter:  verse.Chapter,
-			BookName: verse.BookName,
-			Verse:    verse.Verse,
-			Text:     verse.Text,
-			Keywords: verse.Keywords,
-		})
+		verseResponses = append(verseResponses, verse.proto())
+	}
+	if err := attachHeadings(ctx, request.GetTranslation(), verseResponses); err != nil {
+		return nil, err
+	}
+	if request.GetIncludeFootnotes() {
+		if err := attachFootnotes(ctx, request.GetTranslation(), verseResponses); err != nil {
+			return nil, err
+		}
+	}
+	if request.GetIncludeAnnotations() {
+		if err := attachAnnotations(ctx, verseResponses); err != nil {
+			return nil, err
+		}
 	}
 
 	// return the results to the client
@@ -459,7 +578,7 @@ func (s server) ChapterRange(ctx context.Context, request *wordsearcher.ChapterR
 	// - If chapter start is larger than chapter end return out of bounds.
 
 	// first get the proper collection
-	collection = db.Database("myFirstDatabase").Collection("verse")
+	collection = db.Database(config.Database).Collection(config.Collections.Verse)
 
 	// validation - error handling
 	if request.GetBook() < 0 {
@@ -482,6 +601,7 @@ func (s server) ChapterRange(ctx context.Context, request *wordsearcher.ChapterR
 			"$lte": request.GetEnd(),
 		},
 	}
+	filter["translation"] = translationFilter(request.GetTranslation())
 
 	// do the Database call and store the results
 	var verses []*Verse
@@ -496,14 +616,20 @@ func (s server) ChapterRange(ctx context.Context, request *wordsearcher.ChapterR
 	// build the protocol buffer response
 	var verseResponses []*wordsearcher.Verse
 	for _, verse := range verses {
-		verseResponses = append(verseResponses, &wordsearcher.Verse{
-			Book:     verse.Book,
-			Chapter:  verse.Chapter,
-			BookName: verse.BookName,
-			Verse:    verse.Verse,
-			Text:     verse.Text,
-			Keywords: verse.Keywords,
-		})
+		verseResponses = append(verseResponses, verse.proto())
+	}
+	if err := attachHeadings(ctx, request.GetTranslation(), verseResponses); err != nil {
+		return nil, err
+	}
+	if request.GetIncludeFootnotes() {
+		if err := attachFootnotes(ctx, request.GetTranslation(), verseResponses); err != nil {
+			return nil, err
+		}
+	}
+	if request.GetIncludeAnnotations() {
+		if err := attachAnnotations(ctx, verseResponses); err != nil {
+			return nil, err
+		}
 	}
 
 	// return the results to the client
@@ -517,7 +643,7 @@ func (s server) CustomRange(ctx context.Context, request *wordsearcher.CustomRan
 	// - Query the Custom Range table and return the results
 
 	// first get the proper collection
-	collection = db.Database("myFirstDatabase").Collection("customrange")
+	collection = db.Database(config.Database).Collection(config.Collections.CustomRange)
 
 	// build filter and query
 	filter := bson.D{
@@ -550,54 +676,194 @@ func main() {
 	// set the logging to be able to catch file name and line number in the log
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 
-	// set the logging to be able to catch file name and line number in the log
-	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	err := run()
+	if err == flag.ErrHelp {
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("The server failed: %v", err)
+	}
+	fmt.Println("The server is stopped!")
+}
+
+// run starts the servers and blocks until SIGINT or SIGTERM, or one of them
+// fails, then shuts down. Everything run starts is stopped by the time it
+// returns, as the database is only disconnected by its deferred call.
+func run() error {
+	// load the configuration from the defaults, config file, environment and flags
+	var err error
+	config, err = wsconfig.Load("ws_server", os.Args[1:])
+	if err != nil {
+		return err
+	}
 
 	// connect to Mongodb and initialize the global variables
 	fmt.Println("Connecting to MongoDB...")
 	mongoCtx = context.Background()
-	mongoURI := os.Getenv("MONGOURI")
-	var err error
-	db, err = mongo.Connect(mongoCtx, options.Client().ApplyURI(mongoURI))
+	db, err = mongo.Connect(mongoCtx, options.Client().
+		ApplyURI(config.MongoURI).
+		SetConnectTimeout(config.ConnectTimeout).
+		SetServerSelectionTimeout(config.ConnectTimeout))
 	if err != nil {
-		log.Fatalf("Error connecting to MongoDB client: %v", err)
+		return fmt.Errorf("error connecting to MongoDB client: %v", err)
 	}
 	defer func() {
-		if err = db.Disconnect(mongoCtx); err != nil {
-			panic(err)
+		fmt.Println("Disconnecting from MongoDB...")
+		disconnectCtx, cancel := context.WithTimeout(mongoCtx, config.ConnectTimeout)
+		defer cancel()
+		if err := db.Disconnect(disconnectCtx); err != nil {
+			log.Printf("Error disconnecting from MongoDB: %v", err)
 		}
 	}()
 
+	// personal RPCs need a token signed with the token secret
+	initTokenSecret(config.TokenSecret)
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(timeoutInterceptor, authInterceptor),
+		grpc.MaxRecvMsgSize(config.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(config.MaxSendMsgSize),
+	}
+
+	// TLS, and mutual TLS with a client CA, reloading the certificate files
+	// when they change
+	if config.TLS.Enabled() {
+		reloader, err := newCertReloader(config.TLS)
+		if err != nil {
+			return err
+		}
+		reloadCtx, stopReload := context.WithCancel(context.Background())
+		defer stopReload()
+		go reloader.watch(reloadCtx)
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(reloader.config())))
+	}
+
 	// Start the server
-	fmt.Println("WordSearcher Server started!")
-	lis, err := net.Listen("tcp", "0.0.0.0:50051")
+	lis, err := net.Listen("tcp", config.Address)
 	if err != nil {
-		log.Fatalf("Failed to run the server: %v", err)
+		return fmt.Errorf("failed to run the server: %v", err)
 	}
-
-	s := grpc.NewServer()
+	fmt.Printf("WordSearcher Server started on %s, TLS: %v, mutual TLS: %v!\n",
+		config.Address, config.TLS.Enabled(), config.TLS.ClientCAFile != "")
+	s := grpc.NewServer(serverOptions...)
 	wordsearcher.RegisterWordsearcherServiceServer(s, &server{})
 
+	// grpcurl and Kubernetes probes: reflection, and health from a database ping
+	if config.Features.Reflection {
+		reflection.Register(s)
+	}
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	healthCtx, stopHealth := context.WithCancel(mongoCtx)
+	defer stopHealth()
+	go watchDatabase(healthCtx, healthServer)
+
+	// the servers report failing to serve here, ending run like a signal
+	serveErr := make(chan error, 2)
 	go func() {
 		if err := s.Serve(lis); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
+			serveErr <- fmt.Errorf("failed to serve: %v", err)
 		}
 	}()
 
-	// Use a Wait channel to gracefully stop the servewr
+	// the REST/JSON gateway and gRPC-Web for browsers, calling the same server
+	var httpServer *http.Server
+	if config.Features.Gateway || config.Features.GRPCWeb {
+		var handler http.Handler = http.NotFoundHandler()
+		if config.Features.Gateway {
+			restGateway, err := newGateway(&server{})
+			if err != nil {
+				s.Stop()
+				return fmt.Errorf("failed to build the REST gateway: %v", err)
+			}
+			mux := http.NewServeMux()
+			if config.Features.Docs {
+				docs, err := openAPIHandler()
+				if err != nil {
+					s.Stop()
+					return fmt.Errorf("failed to build the OpenAPI document: %v", err)
+				}
+				mux.Handle("/openapi.json", docs)
+				mux.Handle("/docs", explorerHandler())
+				fmt.Println("API explorer at /docs, OpenAPI document at /openapi.json")
+			}
+			mux.Handle("/", restGateway)
+			handler = mux
+		}
+		if config.Features.GRPCWeb {
+			handler = newGRPCWebServer(s, handler, config.CORSOrigins)
+		}
+		httpServer = &http.Server{Addr: config.GatewayAddress, Handler: handler}
+		fmt.Printf("REST gateway: %v, gRPC-Web: %v, listening on %s, allowing origins %v\n",
+			config.Features.Gateway, config.Features.GRPCWeb, config.GatewayAddress, config.CORSOrigins)
+		go func() {
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				serveErr <- fmt.Errorf("failed to serve the REST gateway: %v", err)
+			}
+		}()
+	}
+
+	// block the rest of the code until SIGINT (Ctrl-C) or SIGTERM (docker
+	// stop, Kubernetes), or a server fails
 	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, os.Interrupt)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(ch)
+	select {
+	case sig := <-ch:
+		fmt.Printf("Received %v, stopping the server...\n", sig)
+	case err = <-serveErr:
+		log.Printf("Stopping the server: %v", err)
+	}
+
+	// probes see NOT_SERVING first so load balancers stop sending calls, then
+	// the calls in flight drain until the shutdown timeout
+	stopHealth()
+	healthServer.Shutdown()
+	shutdown(s, httpServer)
+	return err
+}
 
-	// block the rest of the code until interrupt signal
-	<-ch
+// shutdown drains the gRPC server and the HTTP server of the REST gateway
+// and gRPC-Web together, and stops them when the calls still running are
+// not done within the shutdown timeout
+func shutdown(s *grpc.Server, httpServer *http.Server) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+	defer cancel()
 
-	// Now Properly stop the server
-	fmt.Println("Stopping the server...")
-	s.Stop()
-	fmt.Println("Closing the listener...")
-	err = lis.Close()
-	if err != nil {
-		return
+	drained := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(drained)
+	}()
+	if httpServer != nil {
+		fmt.Println("Draining the REST gateway...")
+		if err := httpServer.Shutdown(ctx); err != nil {
+			log.Printf("The REST gateway did not drain in %v, closing it: %v", config.ShutdownTimeout, err)
+			_ = httpServer.Close()
+		}
 	}
-	fmt.Println("The server is stopped!")
+
+	fmt.Println("Draining the gRPC server...")
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		log.Printf("The gRPC server did not drain in %v, stopping it", config.ShutdownTimeout)
+		s.Stop()
+		<-drained
+	}
+}
+
+// withRequestTimeout gives a call the configured deadline, unless it already
+// has an earlier one
+func withRequestTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if config.RequestTimeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, config.RequestTimeout)
+}
+
+// timeoutInterceptor applies the request timeout to every RPC
+func timeoutInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, cancel := withRequestTimeout(ctx)
+	defer cancel()
+	return handler(ctx, req)
 }
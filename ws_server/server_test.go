@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jwjones2/wordsearcher-server/wsconfig"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestPlanDayIndex(t *testing.T) {
+	tests := []struct {
+		day  int32
+		days int
+		want int
+	}{
+		{1, 365, 0},     // the first reading
+		{365, 365, 364}, // the last reading
+		{366, 365, 0},   // a leap year wraps around to the start
+		{2, 1, 0},       // a one day plan
+		{30, 7, 1},      // a weekly plan wraps several times
+	}
+	for _, test := range tests {
+		if got := planDayIndex(test.day, test.days); got != test.want {
+			t.Errorf("planDayIndex(%d, %d) = %d, want %d", test.day, test.days, got, test.want)
+		}
+	}
+}
+
+// testServers serves a gRPC server with health and, when withHTTP, an HTTP
+// server whose requests block until the test ends
+func testServers(t *testing.T, withHTTP bool) (*grpc.Server, string, *http.Server, string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+	if !withHTTP {
+		return s, lis.Addr().String(), nil, ""
+	}
+
+	httpLis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	release := make(chan struct{})
+	httpServer := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})}
+	go func() { _ = httpServer.Serve(httpLis) }()
+	t.Cleanup(func() {
+		close(release)
+		_ = httpServer.Close()
+	})
+	return s, lis.Addr().String(), httpServer, httpLis.Addr().String()
+}
+
+// timeShutdown runs shutdown, failing when it takes longer than max
+func timeShutdown(t *testing.T, s *grpc.Server, httpServer *http.Server, max time.Duration) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		shutdown(s, httpServer)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(max):
+		t.Fatalf("shutdown did not return in %v", max)
+	}
+}
+
+func TestShutdownIdle(t *testing.T) {
+	savedConfig := config
+	t.Cleanup(func() { config = savedConfig })
+	config = wsconfig.Default()
+	config.ShutdownTimeout = time.Minute
+
+	for _, withHTTP := range []bool{false, true} {
+		s, address, httpServer, httpAddress := testServers(t, withHTTP)
+		timeShutdown(t, s, httpServer, 5*time.Second)
+
+		// nothing is listening once shutdown returns
+		if _, err := net.Dial("tcp", address); err == nil {
+			t.Errorf("the gRPC server still accepts connections")
+		}
+		if withHTTP {
+			if _, err := net.Dial("tcp", httpAddress); err == nil {
+				t.Errorf("the HTTP server still accepts connections")
+			}
+		}
+	}
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	savedConfig := config
+	t.Cleanup(func() { config = savedConfig })
+	config = wsconfig.Default()
+	config.ShutdownTimeout = 100 * time.Millisecond
+
+	s, address, httpServer, httpAddress := testServers(t, true)
+
+	// a health watch streams until the server stops
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	stream, err := healthpb.NewHealthClient(conn).Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a REST call is still running when the shutdown starts
+	requestStarted := make(chan struct{})
+	go func() {
+		close(requestStarted)
+		response, err := http.Get("http://" + httpAddress)
+		if err == nil {
+			response.Body.Close()
+		}
+	}()
+	<-requestStarted
+	time.Sleep(50 * time.Millisecond)
+
+	timeShutdown(t, s, httpServer, 5*time.Second)
+	if _, err := stream.Recv(); status.Code(err) != codes.Unavailable {
+		t.Errorf("the stream got %v after shutdown, want Unavailable", err)
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jwjones2/wordsearcher-server/wsconfig"
+)
+
+// certReloader holds the certificate of the gRPC server and the client CAs
+// of mutual TLS, and loads them again when their files change. Handshakes
+// always get the latest pair, connections already open keep theirs.
+type certReloader struct {
+	files wsconfig.TLS
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  []time.Time
+}
+
+// newCertReloader loads the files, failing when they are not valid
+func newCertReloader(files wsconfig.TLS) (*certReloader, error) {
+	r := &certReloader{files: files}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// load reads the certificate, key and client CAs, replacing the current ones
+// only when all of them are valid
+func (r *certReloader) load() error {
+	modTimes, err := r.fileModTimes()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.files.CertFile, r.files.KeyFile)
+	if err != nil {
+		return fmt.Errorf("error loading the certificate: %v", err)
+	}
+	var clientCAs *x509.CertPool
+	if r.files.ClientCAFile != "" {
+		pem, err := ioutil.ReadFile(r.files.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("error reading the client CA: %v", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in the client CA %s", r.files.ClientCAFile)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.clientCAs, r.modTimes = &cert, clientCAs, modTimes
+	r.mu.Unlock()
+	return nil
+}
+
+// fileModTimes are the modification times of the files, in order
+func (r *certReloader) fileModTimes() ([]time.Time, error) {
+	var modTimes []time.Time
+	for _, file := range []string{r.files.CertFile, r.files.KeyFile, r.files.ClientCAFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	return modTimes, nil
+}
+
+// changed reports whether a file was modified since it was loaded
+func (r *certReloader) changed() bool {
+	modTimes, err := r.fileModTimes()
+	if err != nil {
+		// a file in the middle of being replaced, try again next time
+		return false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for i := range modTimes {
+		if !modTimes[i].Equal(r.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// watch checks the files every reload interval until ctx is done, keeping
+// the current certificate when the new files do not load
+func (r *certReloader) watch(ctx context.Context) {
+	ticker := time.NewTicker(r.files.ReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			if err := r.load(); err != nil {
+				log.Printf("Keeping the current TLS certificate: %v", err)
+				continue
+			}
+			log.Printf("Reloaded the TLS certificate from %s", r.files.CertFile)
+		}
+	}
+}
+
+// config is the TLS configuration of the gRPC server, asking every
+// handshake for the current certificate and client CAs
+func (r *certReloader) config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			current := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				NextProtos:   []string{"h2"},
+			}
+			if r.clientCAs != nil {
+				current.ClientCAs = r.clientCAs
+				current.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return current, nil
+		},
+	}
+}
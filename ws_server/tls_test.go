@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jwjones2/wordsearcher-server/wsconfig"
+)
+
+// testCA is a certificate authority made for a test
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "wordsearcher test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue signs a certificate for localhost, returning its PEM certificate and key
+func (ca *testCA) issue(t *testing.T, serial int64, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// writeFile writes a test file, dating it modified so reloads notice it
+func writeFile(t *testing.T, path string, data []byte, modified time.Time) {
+	t.Helper()
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modified, modified); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// handshake connects to the listener with the client config, returning the
+// serial number of the server certificate and the server's handshake error
+func handshake(t *testing.T, listener net.Listener, client *tls.Config) (int64, error) {
+	t.Helper()
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", listener.Addr().String(), client)
+	var serial int64
+	if err == nil {
+		serial = conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64()
+		conn.Close()
+	}
+	return serial, <-serverErr
+}
+
+func TestCertReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wordsearcher-tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ca := newTestCA(t)
+	files := wsconfig.TLS{
+		CertFile:       filepath.Join(dir, "server.pem"),
+		KeyFile:        filepath.Join(dir, "server-key.pem"),
+		ClientCAFile:   filepath.Join(dir, "ca.pem"),
+		ReloadInterval: 10 * time.Millisecond,
+	}
+	loaded := time.Now().Add(-time.Minute)
+	serverCert, serverKey := ca.issue(t, 100, x509.ExtKeyUsageServerAuth)
+	writeFile(t, files.CertFile, serverCert, loaded)
+	writeFile(t, files.KeyFile, serverKey, loaded)
+	writeFile(t, files.ClientCAFile, ca.pem, loaded)
+
+	reloader, err := newCertReloader(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reloader.watch(ctx)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", reloader.config())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(ca.pem)
+	clientCert, clientKey := ca.issue(t, 200, x509.ExtKeyUsageClientAuth)
+	certificate, err := tls.X509KeyPair(clientCert, clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("without a client certificate", func(t *testing.T) {
+		_, err := handshake(t, listener, &tls.Config{RootCAs: roots, ServerName: "localhost"})
+		if err == nil {
+			t.Errorf("the handshake without a client certificate succeeded")
+		}
+	})
+
+	t.Run("with a client certificate", func(t *testing.T) {
+		serial, err := handshake(t, listener, &tls.Config{RootCAs: roots, ServerName: "localhost", Certificates: []tls.Certificate{certificate}})
+		if err != nil {
+			t.Fatalf("the handshake failed: %v", err)
+		}
+		if serial != 100 {
+			t.Errorf("served certificate %d, want 100", serial)
+		}
+	})
+
+	t.Run("after the files change", func(t *testing.T) {
+		newCert, newKey := ca.issue(t, 101, x509.ExtKeyUsageServerAuth)
+		writeFile(t, files.KeyFile, newKey, time.Now())
+		writeFile(t, files.CertFile, newCert, time.Now())
+
+		client := &tls.Config{RootCAs: roots, ServerName: "localhost", Certificates: []tls.Certificate{certificate}}
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			serial, err := handshake(t, listener, client)
+			if err != nil {
+				t.Fatalf("the handshake failed: %v", err)
+			}
+			if serial == 101 {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("still served certificate %d after the files changed", serial)
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+	})
+
+	t.Run("after an invalid change", func(t *testing.T) {
+		writeFile(t, files.CertFile, []byte("not a certificate"), time.Now().Add(time.Minute))
+		time.Sleep(50 * time.Millisecond)
+
+		serial, err := handshake(t, listener, &tls.Config{RootCAs: roots, ServerName: "localhost", Certificates: []tls.Certificate{certificate}})
+		if err != nil {
+			t.Fatalf("the handshake failed: %v", err)
+		}
+		if serial != 101 {
+			t.Errorf("served certificate %d, want the last valid one, 101", serial)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Translation struct for the translation collection, the settings of each
+// translation loaded into the verse collection (registered with ws_import translation)
+type Translation struct {
+	ID       primitive.ObjectID `bson:"id"`
+	Code     string             `bson:"code"`
+	Name     string             `bson:"name"`
+	Language string             `bson:"language"`
+	Analyzer string             `bson:"analyzer"` // wsbible analyzer used for text_folded
+}
+
+// findTranslation looks up a translation by its code, returning a status error
+func findTranslation(ctx context.Context, code string) (*Translation, error) {
+	translationCollection := db.Database(config.Database).Collection(config.Collections.Translation)
+
+	var translation Translation
+	err := translationCollection.FindOne(ctx, bson.M{"code": code}).Decode(&translation)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "Could not find the translation %s.", code)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the translation %s: %v", code, err)
+	}
+	return &translation, nil
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// User struct for the user collection. Unlike the read only collections the
+// _id is kept, it is what the personal collections refer to a user by.
+type User struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Username     string             `bson:"username"`
+	Email        string             `bson:"email"`
+	PasswordHash []byte             `bson:"password_hash"`
+	Admin        bool               `bson:"admin"`
+	Created      time.Time          `bson:"created"`
+}
+
+// proto converts the user into its protocol buffer message
+func (u *User) proto() *wordsearcher.User {
+	return &wordsearcher.User{
+		Id:       u.ID.Hex(),
+		Username: u.Username,
+		Email:    u.Email,
+		Admin:    u.Admin,
+	}
+}
+
+// validUsername is the form of a username, stored lower case
+var validUsername = regexp.MustCompile(`^[a-z0-9_.-]{3,32}$`)
+
+// minPasswordLength is the shortest password Register accepts
+const minPasswordLength = 8
+
+// dummyPasswordHash is compared against when a username does not exist, a
+// bcrypt hash of the same cost as real ones, so Login takes as long for an
+// unknown username and its timing does not tell which usernames exist
+var dummyPasswordHash = []byte("$2a$10$8GNjv8UQlfs13Q9eUixa4ef7O6edrcj7KIMcq9z8PS35L0AFsFV9q")
+
+func (s server) Register(ctx context.Context, request *wordsearcher.RegisterRequest) (*wordsearcher.AuthResponse, error) {
+	// Functionality
+	// - Creates a user with a bcrypt hash of the password and signs them in.
+	//
+	// **Error Handling
+	// - If the username or password is not valid return invalid argument error
+	// - If the username is taken return already exists error
+
+	username := strings.ToLower(strings.TrimSpace(request.GetUsername()))
+	if !validUsername.MatchString(username) {
+		return nil, status.Errorf(codes.InvalidArgument, "The username must be 3 to 32 letters, numbers, '_', '.' or '-'. Invalid: %v", request.GetUsername())
+	}
+	if len(request.GetPassword()) < minPasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "The password must be at least %d characters", minPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(request.GetPassword()), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error hashing the password: %v", err)
+	}
+
+	userCollection, err := usersCollection(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error preparing the user collection: %v", err)
+	}
+	user := &User{
+		Username:     username,
+		Email:        strings.TrimSpace(request.GetEmail()),
+		PasswordHash: hash,
+		Created:      time.Now(),
+	}
+	res, err := userCollection.InsertOne(ctx, user)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, status.Errorf(codes.AlreadyExists, "The username %s is taken", username)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error saving the user: %v", err)
+	}
+	user.ID = res.InsertedID.(primitive.ObjectID)
+
+	return authResponse(user)
+}
+
+func (s server) Login(ctx context.Context, request *wordsearcher.LoginRequest) (*wordsearcher.AuthResponse, error) {
+	// Functionality
+	// - Checks a username and password and returns a signed token for the personal RPCs.
+	//
+	// **Error Handling
+	// - If the username or password is wrong return unauthenticated error, without
+	//   saying which
+
+	userCollection := db.Database(config.Database).Collection(config.Collections.User)
+	var user *User
+	filter := bson.M{"username": strings.ToLower(strings.TrimSpace(request.GetUsername()))}
+	err := userCollection.FindOne(ctx, filter).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(request.GetPassword()))
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid username or password")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the user: %v", err)
+	}
+	if bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(request.GetPassword())) != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid username or password")
+	}
+
+	return authResponse(user)
+}
+
+func (s server) Me(ctx context.Context, request *wordsearcher.MeRequest) (*wordsearcher.UserResponse, error) {
+	// Functionality
+	// - Returns the signed in user.
+	//
+	// **Error Handling
+	// - If the user was deleted since signing in return not found error
+
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &wordsearcher.UserResponse{
+		User: user.proto(),
+	}, nil
+}
+
+// currentUser loads the signed in user of a call
+func currentUser(ctx context.Context) (*User, error) {
+	id, err := signedInUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userCollection := db.Database(config.Database).Collection(config.Collections.User)
+	var user *User
+	err = userCollection.FindOne(ctx, bson.M{"_id": id}).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "The signed in user no longer exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the user: %v", err)
+	}
+	return user, nil
+}
+
+// signedInUserID returns the id of the signed in user of a call, the key of
+// the personal collections
+func signedInUserID(ctx context.Context) (primitive.ObjectID, error) {
+	signedIn, ok := userFromContext(ctx)
+	if !ok {
+		return primitive.NilObjectID, status.Errorf(codes.Unauthenticated, "This needs a signed in user")
+	}
+	id, err := primitive.ObjectIDFromHex(signedIn.ID)
+	if err != nil {
+		return primitive.NilObjectID, status.Errorf(codes.Unauthenticated, "The token has an invalid user")
+	}
+	return id, nil
+}
+
+// usersCollection returns the user collection, making sure usernames are unique
+func usersCollection(ctx context.Context) (*mongo.Collection, error) {
+	userCollection := db.Database(config.Database).Collection(config.Collections.User)
+	_, err := userCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "username", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return userCollection, err
+}
+
+// authResponse signs a token for the user
+func authResponse(user *User) (*wordsearcher.AuthResponse, error) {
+	expires := time.Now().Add(tokenLifetime).Unix()
+	token, err := signToken(authUser{
+		ID:       user.ID.Hex(),
+		Username: user.Username,
+		Admin:    user.Admin,
+		Expires:  expires,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error signing the token: %v", err)
+	}
+	return &wordsearcher.AuthResponse{
+		Token:   token,
+		Expires: expires,
+		User:    user.proto(),
+	}, nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestDummyPasswordHash(t *testing.T) {
+	// the dummy hash must be a valid hash of the default cost, or the
+	// comparison would fail fast and Login would leak unknown usernames
+	cost, err := bcrypt.Cost(dummyPasswordHash)
+	if err != nil {
+		t.Fatalf("dummyPasswordHash is not a bcrypt hash: %v", err)
+	}
+	if cost != bcrypt.DefaultCost {
+		t.Errorf("dummyPasswordHash has cost %d, want %d", cost, bcrypt.DefaultCost)
+	}
+	if bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte("password")) == nil {
+		t.Errorf("dummyPasswordHash matches a guessable password")
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand"
+	"time"
+
+	"github.com/jwjones2/wordsearcher-server/wsbible"
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerseList struct for the verselist collection, a curated list of passages
+// for the verse of the day (loaded with ws_import verses)
+type VerseList struct {
+	ID         primitive.ObjectID `bson:"id"`
+	Name       string             `bson:"name"`
+	References []string           `bson:"references"`
+}
+
+// maxVerseHistory is the most past days VerseOfTheDay returns at once
+const maxVerseHistory = 31
+
+func (s server) VerseOfTheDay(ctx context.Context, request *wordsearcher.VerseOfTheDayRequest) (*wordsearcher.VerseOfTheDayResponse, error) {
+	// Functionality
+	// - Picks the verse of a date from a verse list, or from the verses of a
+	//   CustomRange, the same for every client. Each cycle through the list is
+	//   a fresh shuffle, so no passage repeats until all of them were shown.
+	// - Also returns the verses of the days before the date, for a history.
+	//
+	// **Error Handling
+	// - If not exactly one of list and custom_range is given return invalid argument error
+	// - If the date or timezone is invalid, or the date is still to come, return invalid argument error
+	// - If the list or custom range is missing or empty return not found error
+
+	if (request.GetList() == "") == (request.GetCustomRange() == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "Give either a list or a custom_range")
+	}
+	if request.GetHistory() < 0 || request.GetHistory() > maxVerseHistory {
+		return nil, status.Errorf(codes.OutOfRange, "The history must be between 0 and %d days. Invalid: %v", maxVerseHistory, request.GetHistory())
+	}
+	timezone := request.GetTimezone()
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid timezone: %v", request.GetTimezone())
+	}
+
+	// dates are parsed in UTC so every day is 24 hours, the timezone only
+	// decides which date today is
+	today, _ := time.Parse(dateLayout, time.Now().In(loc).Format(dateLayout))
+	date := today
+	if request.GetDate() != "" {
+		if date, err = time.Parse(dateLayout, request.GetDate()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "The date must be YYYY-MM-DD. Invalid: %v", request.GetDate())
+		}
+		if date.After(today) {
+			return nil, status.Errorf(codes.InvalidArgument, "The verse of %s is not given out yet", request.GetDate())
+		}
+	}
+
+	// the passages to rotate through, and the name seeding their shuffle
+	var passages []wsbible.Reference
+	name := request.GetList()
+	if name != "" {
+		passages, err = listPassages(ctx, name)
+	} else {
+		name = request.GetCustomRange()
+		passages, err = s.rangePassages(ctx, name, request.GetTranslation())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &wordsearcher.VerseOfTheDayResponse{}
+	for back := int32(0); back <= request.GetHistory(); back++ {
+		day := date.AddDate(0, 0, -int(back))
+		passage := passages[rotationIndex(name, day, len(passages))]
+		verses, err := s.passageVerses(ctx, passage, request.GetTranslation())
+		if err != nil {
+			return nil, err
+		}
+		verseOfTheDay := &wordsearcher.VerseOfTheDay{
+			Date:      day.Format(dateLayout),
+			List:      name,
+			Reference: passage.String(),
+			Verses:    verses,
+		}
+		if back == 0 {
+			response.VerseOfTheDay = verseOfTheDay
+		} else {
+			response.History = append(response.History, verseOfTheDay)
+		}
+	}
+	return response, nil
+}
+
+// rotationIndex picks the passage of a date. The days since 1970 are split in
+// cycles as long as the list, each cycle a shuffle seeded by the list name and
+// the cycle number, so the pick only depends on the name, date and length.
+// Only the calendar date counts, not the time of day or its zone.
+func rotationIndex(name string, date time.Time, n int) int {
+	year, month, day := date.Date()
+	days := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+	cycle, position := days/int64(n), days%int64(n)
+	if position < 0 {
+		cycle, position = cycle-1, position+int64(n)
+	}
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	shuffle := rand.New(rand.NewSource(int64(h.Sum64()) + cycle)).Perm(n)
+	return shuffle[position]
+}
+
+// listPassages returns the passages of a verse list
+func listPassages(ctx context.Context, name string) ([]wsbible.Reference, error) {
+	listCollection := db.Database(config.Database).Collection(config.Collections.VerseList)
+	var list VerseList
+	err := listCollection.FindOne(ctx, bson.M{"name": name}).Decode(&list)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "There is no verse list named %q", name)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error finding the verse list: %v", err)
+	}
+
+	var passages []wsbible.Reference
+	for _, text := range list.References {
+		passage, err := wsbible.ParseReference(text)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "The verse list %q has an invalid reference %q: %v", name, text, err)
+		}
+		passages = append(passages, passage)
+	}
+	if len(passages) == 0 {
+		return nil, status.Errorf(codes.NotFound, "The verse list %q is empty", name)
+	}
+	return passages, nil
+}
+
+// rangePassages returns each verse of a CustomRange as a passage, in reading order
+func (s server) rangePassages(ctx context.Context, name, translation string) ([]wsbible.Reference, error) {
+	scope, err := s.generateScope(ctx, &wordsearcher.PlanTrackScope{CustomRange: name})
+	if err != nil {
+		return nil, err
+	}
+	units, _, err := planUnits(ctx, scope, "verses", false, translation)
+	if err != nil {
+		return nil, err
+	}
+
+	var passages []wsbible.Reference
+	for _, unit := range units {
+		passages = append(passages, wsbible.Reference{
+			Book:       unit.Book,
+			Chapter:    unit.Chapter,
+			Verse:      unit.Verse,
+			EndBook:    unit.Book,
+			EndChapter: unit.Chapter,
+			EndVerse:   unit.Verse,
+		})
+	}
+	if len(passages) == 0 {
+		return nil, status.Errorf(codes.NotFound, "The custom range %q has no verses", name)
+	}
+	return passages, nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	wordsearcher "github.com/jwjones2/wordsearcher-server/wspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// epochDay is the date a number of days after January 1, 1970
+func epochDay(day int64) time.Time {
+	return time.Unix(day*24*60*60, 0).UTC()
+}
+
+func TestRotationIndexIsStable(t *testing.T) {
+	date := time.Date(2021, time.March, 14, 0, 0, 0, 0, time.UTC)
+	want := rotationIndex("Promises", date, 50)
+	tests := []struct {
+		name string
+		date time.Time
+	}{
+		{"the same date", date},
+		{"later that day", date.Add(23 * time.Hour)},
+		{"in another zone", time.Date(2021, time.March, 14, 0, 0, 0, 0, time.FixedZone("UTC+14", 14*60*60))},
+	}
+	for _, test := range tests {
+		if got := rotationIndex("Promises", test.date, 50); got != want {
+			t.Errorf("%s: rotationIndex = %d, want %d", test.name, got, want)
+		}
+	}
+	if got := rotationIndex("Anything", date, 1); got != 0 {
+		t.Errorf("rotationIndex of a one passage list = %d, want 0", got)
+	}
+}
+
+func TestRotationIndexCycles(t *testing.T) {
+	tests := []struct {
+		name  string
+		n     int
+		cycle int64
+	}{
+		{"the first cycle", 7, 0},
+		{"a cycle in 2021", 7, 18700 / 7},
+		{"the cycle before 1970", 7, -1},
+		{"a cycle in 1900", 30, -25567 / 30},
+		{"a long list", 365, 51},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var picks []int
+			for day := test.cycle * int64(test.n); day < (test.cycle+1)*int64(test.n); day++ {
+				picks = append(picks, rotationIndex("Promises", epochDay(day), test.n))
+			}
+			sorted := append([]int{}, picks...)
+			sort.Ints(sorted)
+			for i := range sorted {
+				if sorted[i] != i {
+					t.Fatalf("the cycle picks %v, not every passage once", picks)
+				}
+			}
+		})
+	}
+}
+
+func TestRotationIndexShuffles(t *testing.T) {
+	cycle := func(name string, first int64) []int {
+		var picks []int
+		for day := first; day < first+30; day++ {
+			picks = append(picks, rotationIndex(name, epochDay(day), 30))
+		}
+		return picks
+	}
+	if reflect.DeepEqual(cycle("Promises", 0), cycle("Psalms", 0)) {
+		t.Errorf("two lists share an order")
+	}
+	if reflect.DeepEqual(cycle("Promises", 0), cycle("Promises", 30)) {
+		t.Errorf("two cycles share an order")
+	}
+}
+
+func TestVerseOfTheDayRejects(t *testing.T) {
+	tomorrow := time.Now().UTC().AddDate(0, 0, 2).Format(dateLayout)
+	tests := []struct {
+		name    string
+		request *wordsearcher.VerseOfTheDayRequest
+		code    codes.Code
+	}{
+		{"no list", &wordsearcher.VerseOfTheDayRequest{}, codes.InvalidArgument},
+		{"a list and a custom range", &wordsearcher.VerseOfTheDayRequest{List: "Promises", CustomRange: "Psalms"}, codes.InvalidArgument},
+		{"too much history", &wordsearcher.VerseOfTheDayRequest{List: "Promises", History: maxVerseHistory + 1}, codes.OutOfRange},
+		{"an unknown time zone", &wordsearcher.VerseOfTheDayRequest{List: "Promises", Timezone: "Mars/Olympus"}, codes.InvalidArgument},
+		{"an invalid date", &wordsearcher.VerseOfTheDayRequest{List: "Promises", Date: "14/03/2021"}, codes.InvalidArgument},
+		{"a date to come", &wordsearcher.VerseOfTheDayRequest{List: "Promises", Date: tomorrow}, codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := server{}.VerseOfTheDay(context.Background(), test.request)
+			if status.Code(err) != test.code {
+				t.Errorf("got %v, want %v", err, test.code)
+			}
+		})
+	}
+}
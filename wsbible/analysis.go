@@ -0,0 +1,69 @@
+package wsbible
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Text analyzers, chosen per translation, decide how verse text and search
+// terms are folded before indexing so that unpointed or unaccented queries
+// match pointed and accented text.
+const (
+	// AnalyzerStandard leaves the text alone and searches the text field.
+	AnalyzerStandard = "standard"
+	// AnalyzerGreek strips accents and breathing marks and folds final sigma.
+	AnalyzerGreek = "greek"
+	// AnalyzerHebrew strips niqqud (vowel points) and cantillation marks.
+	AnalyzerHebrew = "hebrew"
+)
+
+// Analyzers lists the known analyzer names
+var Analyzers = []string{AnalyzerStandard, AnalyzerGreek, AnalyzerHebrew}
+
+// Folds reports whether the analyzer folds text, in which case the folded
+// text is indexed and searched instead of the raw text.
+func Folds(analyzer string) bool {
+	return analyzer == AnalyzerGreek || analyzer == AnalyzerHebrew
+}
+
+// Fold normalizes text with the named analyzer. The result is NFC normalized
+// and lower case, unknown or standard analyzers only normalize.
+func Fold(analyzer, text string) string {
+	switch analyzer {
+	case AnalyzerGreek:
+		return norm.NFC.String(strings.Map(foldGreek, norm.NFD.String(text)))
+	case AnalyzerHebrew:
+		return norm.NFC.String(strings.Map(foldHebrew, norm.NFD.String(text)))
+	}
+	return norm.NFC.String(text)
+}
+
+// foldGreek drops the combining accents, breathings and diaeresis left by NFD
+// decomposition, lower cases and folds final sigma
+func foldGreek(r rune) rune {
+	if unicode.Is(unicode.Mn, r) {
+		return -1
+	}
+	r = unicode.ToLower(r)
+	if r == 'ς' {
+		return 'σ'
+	}
+	return r
+}
+
+// foldHebrew drops the niqqud and cantillation marks (the combining marks of
+// the Hebrew block, U+0591 to U+05C7), turns maqaf into a space and drops
+// paseq and sof pasuq. Accents of other scripts are kept.
+func foldHebrew(r rune) rune {
+	switch {
+	case r == '־': // maqaf joins words, split them for searching
+		return ' '
+	case r == '׀' || r == '׃' || r == '/': // paseq, sof pasuq, morpheme separators
+		return -1
+	case r >= '\u0591' && r <= '\u05C7' && unicode.Is(unicode.Mn, r):
+		return -1
+	}
+	return unicode.ToLower(r)
+}
@@ -0,0 +1,45 @@
+package wsbible
+
+import "testing"
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		analyzer, text, want string
+	}{
+		{AnalyzerGreek, "Ἐν ἀρχῇ ἦν ὁ λόγος", "εν αρχη ην ο λογοσ"},
+		{AnalyzerGreek, "ΛΌΓΟΣ", "λογοσ"},
+		{AnalyzerGreek, "Μωϋσῆς", "μωυσησ"},
+		{AnalyzerHebrew, "בְּרֵאשִׁ֖ית", "בראשית"},
+		{AnalyzerHebrew, "אֵ֥ת הַשָּׁמַ֖יִם וְאֵ֥ת הָאָֽרֶץ׃", "את השמים ואת הארץ"},
+		{AnalyzerHebrew, "עַל־פְּנֵ֣י", "על פני"},
+		// only Hebrew points are stripped, other accents stay
+		{AnalyzerHebrew, "Café בְּרֵאשִׁית λόγος", "café בראשית λόγος"},
+		// standard and unknown analyzers only normalize to NFC
+		{AnalyzerStandard, "In the Beginning", "In the Beginning"},
+		{"", "λόγος", "λόγος"},
+		{AnalyzerStandard, "λόγος", "λόγος"},
+	}
+	for _, test := range tests {
+		if got := Fold(test.analyzer, test.text); got != test.want {
+			t.Errorf("Fold(%q, %q) = %q, want %q", test.analyzer, test.text, got, test.want)
+		}
+	}
+}
+
+func TestFolds(t *testing.T) {
+	tests := []struct {
+		analyzer string
+		want     bool
+	}{
+		{AnalyzerGreek, true},
+		{AnalyzerHebrew, true},
+		{AnalyzerStandard, false},
+		{"", false},
+		{"latin", false},
+	}
+	for _, test := range tests {
+		if got := Folds(test.analyzer); got != test.want {
+			t.Errorf("Folds(%q) = %v, want %v", test.analyzer, got, test.want)
+		}
+	}
+}
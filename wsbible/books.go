@@ -0,0 +1,220 @@
+// Package wsbible holds the Bible metadata shared by the WordSearcher
+// server and its tools: the book table, reference parsing and formatting.
+package wsbible
+
+import "strings"
+
+// Book describes one book of the 66 book Protestant canon. Number matches
+// the "book" field stored on every verse document.
+type Book struct {
+	Number   int32
+	Name     string
+	OSIS     string
+	USFM     string
+	Chapters int32
+}
+
+// Books is the book table, indexed by Number-1.
+var Books = []Book{
+	{1, "Genesis", "Gen", "GEN", 50},
+	{2, "Exodus", "Exod", "EXO", 40},
+	{3, "Leviticus", "Lev", "LEV", 27},
+	{4, "Numbers", "Num", "NUM", 36},
+	{5, "Deuteronomy", "Deut", "DEU", 34},
+	{6, "Joshua", "Josh", "JOS", 24},
+	{7, "Judges", "Judg", "JDG", 21},
+	{8, "Ruth", "Ruth", "RUT", 4},
+	{9, "1 Samuel", "1Sam", "1SA", 31},
+	{10, "2 Samuel", "2Sam", "2SA", 24},
+	{11, "1 Kings", "1Kgs", "1KI", 22},
+	{12, "2 Kings", "2Kgs", "2KI", 25},
+	{13, "1 Chronicles", "1Chr", "1CH", 29},
+	{14, "2 Chronicles", "2Chr", "2CH", 36},
+	{15, "Ezra", "Ezra", "EZR", 10},
+	{16, "Nehemiah", "Neh", "NEH", 13},
+	{17, "Esther", "Esth", "EST", 10},
+	{18, "Job", "Job", "JOB", 42},
+	{19, "Psalms", "Ps", "PSA", 150},
+	{20, "Proverbs", "Prov", "PRO", 31},
+	{21, "Ecclesiastes", "Eccl", "ECC", 12},
+	{22, "Song of Solomon", "Song", "SNG", 8},
+	{23, "Isaiah", "Isa", "ISA", 66},
+	{24, "Jeremiah", "Jer", "JER", 52},
+	{25, "Lamentations", "Lam", "LAM", 5},
+	{26, "Ezekiel", "Ezek", "EZK", 48},
+	{27, "Daniel", "Dan", "DAN", 12},
+	{28, "Hosea", "Hos", "HOS", 14},
+	{29, "Joel", "Joel", "JOL", 3},
+	{30, "Amos", "Amos", "AMO", 9},
+	{31, "Obadiah", "Obad", "OBA", 1},
+	{32, "Jonah", "Jonah", "JON", 4},
+	{33, "Micah", "Mic", "MIC", 7},
+	{34, "Nahum", "Nah", "NAM", 3},
+	{35, "Habakkuk", "Hab", "HAB", 3},
+	{36, "Zephaniah", "Zeph", "ZEP", 3},
+	{37, "Haggai", "Hag", "HAG", 2},
+	{38, "Zechariah", "Zech", "ZEC", 14},
+	{39, "Malachi", "Mal", "MAL", 4},
+	{40, "Matthew", "Matt", "MAT", 28},
+	{41, "Mark", "Mark", "MRK", 16},
+	{42, "Luke", "Luke", "LUK", 24},
+	{43, "John", "John", "JHN", 21},
+	{44, "Acts", "Acts", "ACT", 28},
+	{45, "Romans", "Rom", "ROM", 16},
+	{46, "1 Corinthians", "1Cor", "1CO", 16},
+	{47, "2 Corinthians", "2Cor", "2CO", 13},
+	{48, "Galatians", "Gal", "GAL", 6},
+	{49, "Ephesians", "Eph", "EPH", 6},
+	{50, "Philippians", "Phil", "PHP", 4},
+	{51, "Colossians", "Col", "COL", 4},
+	{52, "1 Thessalonians", "1Thess", "1TH", 5},
+	{53, "2 Thessalonians", "2Thess", "2TH", 3},
+	{54, "1 Timothy", "1Tim", "1TI", 6},
+	{55, "2 Timothy", "2Tim", "2TI", 4},
+	{56, "Titus", "Titus", "TIT", 3},
+	{57, "Philemon", "Phlm", "PHM", 1},
+	{58, "Hebrews", "Heb", "HEB", 13},
+	{59, "James", "Jas", "JAS", 5},
+	{60, "1 Peter", "1Pet", "1PE", 5},
+	{61, "2 Peter", "2Pet", "2PE", 3},
+	{62, "1 John", "1John", "1JN", 5},
+	{63, "2 John", "2John", "2JN", 1},
+	{64, "3 John", "3John", "3JN", 1},
+	{65, "Jude", "Jude", "JUD", 1},
+	{66, "Revelation", "Rev", "REV", 22},
+}
+
+// osisBooks and usfmBooks map lower case OSIS and USFM book ids to book numbers
+var osisBooks = map[string]int32{}
+var usfmBooks = map[string]int32{}
+
+func init() {
+	for _, b := range Books {
+		osisBooks[strings.ToLower(b.OSIS)] = b.Number
+		usfmBooks[strings.ToLower(b.USFM)] = b.Number
+	}
+}
+
+// BookByNumber returns the book with the given number, or false if the
+// number is outside the canon.
+func BookByNumber(number int32) (Book, bool) {
+	if number < 1 || int(number) > len(Books) {
+		return Book{}, false
+	}
+	return Books[number-1], true
+}
+
+// BookByOSIS looks up a book by its OSIS id (Gen, 1Cor...), ignoring case.
+func BookByOSIS(id string) (Book, bool) {
+	number, ok := osisBooks[strings.ToLower(id)]
+	if !ok {
+		return Book{}, false
+	}
+	return Books[number-1], true
+}
+
+// BookByUSFM looks up a book by its USFM id (GEN, 1CO...), ignoring case.
+func BookByUSFM(id string) (Book, bool) {
+	number, ok := usfmBooks[strings.ToLower(id)]
+	if !ok {
+		return Book{}, false
+	}
+	return Books[number-1], true
+}
+
+// bookAliases are common abbreviations and alternate names that are neither
+// the full name nor the OSIS or USFM id, keyed as nameKey returns them.
+var bookAliases = map[string]int32{
+	"gn": 1, "ex": 2, "lv": 3, "nm": 4, "dt": 5, "jdgs": 7, "ru": 8,
+	"jb": 18, "psalm": 19, "pss": 19, "pr": 20, "prv": 20, "qoh": 21, "qoheleth": 21,
+	"songofsongs": 22, "sos": 22, "canticles": 22, "ez": 26,
+	"mt": 40, "mk": 41, "mr": 41, "lk": 42, "jn": 43, "rm": 45,
+	"phil": 50, "phm": 57, "jm": 59, "jud": 65, "revelations": 66, "apocalypse": 66,
+}
+
+// bookNames maps the full names, OSIS and USFM ids and aliases to book numbers
+var bookNames = map[string]int32{}
+
+func init() {
+	for _, b := range Books {
+		bookNames[nameKey(b.Name)] = b.Number
+		bookNames[nameKey(b.OSIS)] = b.Number
+		bookNames[nameKey(b.USFM)] = b.Number
+	}
+	for alias, number := range bookAliases {
+		bookNames[alias] = number
+	}
+}
+
+// nameKey lower cases a book name and drops its spaces and periods, so
+// "1 Cor.", "1cor" and "1 COR" are the same key.
+func nameKey(name string) string {
+	return strings.NewReplacer(" ", "", ".", "").Replace(strings.ToLower(name))
+}
+
+// BookByName looks up a book by the way people write it: the full name, the
+// OSIS or USFM id, a common abbreviation ("Mk", "Ps", "1 Jn"...) or any
+// prefix of the full name that only one book starts with ("Gen", "Deut").
+func BookByName(name string) (Book, bool) {
+	key := nameKey(name)
+	if key == "" {
+		return Book{}, false
+	}
+	if number, ok := bookNames[key]; ok {
+		return Books[number-1], true
+	}
+
+	var found []Book
+	for _, b := range Books {
+		if strings.HasPrefix(nameKey(b.Name), key) {
+			found = append(found, b)
+		}
+	}
+	if len(found) != 1 {
+		return Book{}, false
+	}
+	return found[0], true
+}
+
+// Book genres, the traditional divisions of the Protestant canon
+const (
+	GenreLaw             = "law"
+	GenreHistory         = "history"
+	GenreWisdom          = "wisdom"
+	GenreMajorProphets   = "major prophets"
+	GenreMinorProphets   = "minor prophets"
+	GenreGospels         = "gospels"
+	GenrePaulineEpistles = "pauline epistles"
+	GenreGeneralEpistles = "general epistles"
+	GenreApocalyptic     = "apocalyptic"
+)
+
+// genreRanges lists the last book number of each genre, in canon order
+var genreRanges = []struct {
+	last  int32
+	genre string
+}{
+	{5, GenreLaw},
+	{17, GenreHistory},
+	{22, GenreWisdom},
+	{27, GenreMajorProphets},
+	{39, GenreMinorProphets},
+	{43, GenreGospels},
+	{44, GenreHistory}, // Acts
+	{57, GenrePaulineEpistles},
+	{65, GenreGeneralEpistles},
+	{66, GenreApocalyptic},
+}
+
+// BookGenre returns the genre of a book, blank outside the canon
+func BookGenre(number int32) string {
+	if _, ok := BookByNumber(number); !ok {
+		return ""
+	}
+	for _, r := range genreRanges {
+		if number <= r.last {
+			return r.genre
+		}
+	}
+	return ""
+}
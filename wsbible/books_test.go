@@ -0,0 +1,31 @@
+package wsbible
+
+import "testing"
+
+func TestBookByName(t *testing.T) {
+	tests := []struct {
+		name   string
+		number int32 // 0 when no single book matches
+	}{
+		{"Genesis", 1},
+		{"gen", 1},
+		{"GEN", 1},
+		{"Deut", 5},
+		{"1 Cor.", 46},
+		{"1cor", 46},
+		{"1 Jn", 62},
+		{"Mk", 41},
+		{"Psalm", 19},
+		{"Song of Songs", 22},
+		{"Revelations", 66},
+		{"Jo", 0}, // Job, Joel, Jonah, Joshua, John...
+		{"", 0},
+		{"Hezekiah", 0},
+	}
+	for _, test := range tests {
+		book, ok := BookByName(test.name)
+		if ok != (test.number != 0) || book.Number != test.number {
+			t.Errorf("BookByName(%q) = %d, %v, want %d", test.name, book.Number, ok, test.number)
+		}
+	}
+}
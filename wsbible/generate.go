@@ -0,0 +1,113 @@
+package wsbible
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PlanUnit is a piece of Scripture a generated plan never splits, a verse or
+// a whole chapter (Verse 0), weighted by its verses, words or chapters.
+type PlanUnit struct {
+	Book    int32
+	Chapter int32
+	Verse   int32
+	Weight  float64
+}
+
+// PartitionPlan splits units, in reading order, into days of about equal
+// weight. Each unit goes to the day its middle falls on, so a day never
+// runs more than half a unit over its share, and no day is left empty.
+func PartitionPlan(units []PlanUnit, days int) ([][]PlanUnit, error) {
+	if days < 1 {
+		return nil, fmt.Errorf("a plan needs at least one day")
+	}
+	if len(units) < days {
+		return nil, fmt.Errorf("%d days is more than the %d readings in the scope", days, len(units))
+	}
+	var total float64
+	for _, unit := range units {
+		total += unit.Weight
+	}
+
+	partition := make([][]PlanUnit, days)
+	var done float64
+	prev := 0
+	for i, unit := range units {
+		day := days
+		if total > 0 {
+			day = int((done+unit.Weight/2)*float64(days)/total) + 1
+		}
+		done += unit.Weight
+
+		// stay on the same day or move to the next one, leaving enough
+		// units for the days after it
+		low, high := prev, prev+1
+		if low < 1 {
+			low = 1
+		}
+		if left := days - (len(units) - 1 - i); low < left {
+			low = left
+		}
+		if day < low {
+			day = low
+		}
+		if day > high {
+			day = high
+		}
+		partition[day-1] = append(partition[day-1], unit)
+		prev = day
+	}
+	return partition, nil
+}
+
+// FormatReading formats the units of a day as a reading, joining runs of
+// consecutive chapters or verses into passages separated by semicolons:
+// "Genesis 1-3", "Psalms 119:1-88" or "Malachi 4; Matthew 1". lastVerse
+// holds the last verse of each {book, chapter} so runs of verses covering
+// whole chapters are written as chapters.
+func FormatReading(units []PlanUnit, lastVerse map[[2]int32]int32) string {
+	var passages []string
+	for start := 0; start < len(units); {
+		end := start
+		for end+1 < len(units) && followsUnit(units[end], units[end+1], lastVerse) {
+			end++
+		}
+		first, last := units[start], units[end]
+		ref := Reference{
+			Book:       first.Book,
+			Chapter:    first.Chapter,
+			Verse:      first.Verse,
+			EndBook:    last.Book,
+			EndChapter: last.Chapter,
+			EndVerse:   last.Verse,
+		}
+		if ref.Verse <= 1 && (ref.EndVerse == 0 || ref.EndVerse == lastVerse[[2]int32{last.Book, last.Chapter}]) {
+			ref.Verse, ref.EndVerse = 0, 0
+		} else if ref.Verse == 0 {
+			ref.Verse = 1
+		} else if ref.EndVerse == 0 {
+			ref.EndVerse = lastVerse[[2]int32{last.Book, last.Chapter}]
+		}
+		passages = append(passages, ref.String())
+		start = end + 1
+	}
+	return strings.Join(passages, "; ")
+}
+
+// followsUnit reports whether next comes straight after unit in the same book
+func followsUnit(unit, next PlanUnit, lastVerse map[[2]int32]int32) bool {
+	if unit.Book != next.Book {
+		return false
+	}
+	switch {
+	case unit.Verse == 0 && next.Verse == 0:
+		return next.Chapter == unit.Chapter+1
+	case unit.Verse == 0:
+		return next.Chapter == unit.Chapter+1 && next.Verse == 1
+	case next.Verse == 0:
+		return next.Chapter == unit.Chapter+1 && unit.Verse == lastVerse[[2]int32{unit.Book, unit.Chapter}]
+	case next.Chapter == unit.Chapter:
+		return next.Verse == unit.Verse+1
+	}
+	return next.Chapter == unit.Chapter+1 && next.Verse == 1 && unit.Verse == lastVerse[[2]int32{unit.Book, unit.Chapter}]
+}
@@ -0,0 +1,86 @@
+package wsbible
+
+import (
+	"reflect"
+	"testing"
+)
+
+// weighted makes verse units of Genesis 1 with the weights
+func weighted(weights ...float64) []PlanUnit {
+	var units []PlanUnit
+	for i, weight := range weights {
+		units = append(units, PlanUnit{Book: 1, Chapter: 1, Verse: int32(i + 1), Weight: weight})
+	}
+	return units
+}
+
+func TestPartitionPlan(t *testing.T) {
+	tests := []struct {
+		name  string
+		units []PlanUnit
+		days  int
+		sizes []int // units a day
+		err   bool
+	}{
+		{"equal weights", weighted(1, 1, 1, 1, 1, 1, 1, 1, 1, 1), 5, []int{2, 2, 2, 2, 2}, false},
+		{"one day", weighted(1, 2, 3), 1, []int{3}, false},
+		{"as many days as units", weighted(5, 1, 1), 3, []int{1, 1, 1}, false},
+		{"a heavy first unit", weighted(10, 1, 1, 1), 2, []int{1, 3}, false},
+		{"a heavy middle unit", weighted(1, 100, 1), 3, []int{1, 1, 1}, false},
+		{"balanced by weight", weighted(3, 1, 1, 1, 3, 3), 2, []int{4, 2}, false},
+		{"no weight", weighted(0, 0, 0, 0), 2, []int{1, 3}, false},
+		{"more days than units", weighted(1, 1), 3, nil, true},
+		{"no days", weighted(1, 1), 0, nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			partition, err := PartitionPlan(test.units, test.days)
+			if (err != nil) != test.err {
+				t.Fatalf("error %v, want error %v", err, test.err)
+			}
+			if test.err {
+				return
+			}
+			var sizes []int
+			var order []PlanUnit
+			for _, day := range partition {
+				sizes = append(sizes, len(day))
+				order = append(order, day...)
+			}
+			if !reflect.DeepEqual(sizes, test.sizes) {
+				t.Errorf("day sizes %v, want %v", sizes, test.sizes)
+			}
+			if !reflect.DeepEqual(order, test.units) {
+				t.Errorf("the units are not kept in reading order")
+			}
+		})
+	}
+}
+
+func TestFormatReading(t *testing.T) {
+	lastVerse := map[[2]int32]int32{{1, 1}: 31, {1, 2}: 25, {1, 3}: 24, {39, 4}: 6, {40, 1}: 25}
+	var genesis1 []PlanUnit
+	for verse := int32(1); verse <= 31; verse++ {
+		genesis1 = append(genesis1, PlanUnit{Book: 1, Chapter: 1, Verse: verse})
+	}
+
+	tests := []struct {
+		name  string
+		units []PlanUnit
+		want  string
+	}{
+		{"chapters", []PlanUnit{{Book: 1, Chapter: 1}, {Book: 1, Chapter: 2}, {Book: 1, Chapter: 3}}, "Genesis 1-3"},
+		{"every verse of a chapter", genesis1, "Genesis 1"},
+		{"one verse", []PlanUnit{{Book: 1, Chapter: 1, Verse: 5}}, "Genesis 1:5"},
+		{"verses across chapters", []PlanUnit{{Book: 1, Chapter: 1, Verse: 30}, {Book: 1, Chapter: 1, Verse: 31}, {Book: 1, Chapter: 2, Verse: 1}, {Book: 1, Chapter: 2, Verse: 2}}, "Genesis 1:30-2:2"},
+		{"a chapter then verses", []PlanUnit{{Book: 1, Chapter: 1}, {Book: 1, Chapter: 2, Verse: 1}, {Book: 1, Chapter: 2, Verse: 2}}, "Genesis 1:1-2:2"},
+		{"verses then a chapter", []PlanUnit{{Book: 1, Chapter: 2, Verse: 24}, {Book: 1, Chapter: 2, Verse: 25}, {Book: 1, Chapter: 3}}, "Genesis 2:24-3:24"},
+		{"a gap", []PlanUnit{{Book: 1, Chapter: 1, Verse: 1}, {Book: 1, Chapter: 1, Verse: 3}}, "Genesis 1:1; Genesis 1:3"},
+		{"across books", []PlanUnit{{Book: 39, Chapter: 4}, {Book: 40, Chapter: 1}}, "Malachi 4; Matthew 1"},
+	}
+	for _, test := range tests {
+		if got := FormatReading(test.units, lastVerse); got != test.want {
+			t.Errorf("%s: FormatReading = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package wsbible
+
+import "strings"
+
+// Span types for the inline markup of a verse
+const (
+	// SpanWordsOfChrist marks the words of Jesus, rendered in red letter editions.
+	SpanWordsOfChrist = "woc"
+	// SpanAdded marks words supplied by the translators, italics in the KJV.
+	SpanAdded = "added"
+	// SpanDivineName marks the divine name, LORD in small caps.
+	SpanDivineName = "divine_name"
+)
+
+// Span is a run of marked up text in a verse. Start and End are offsets into
+// the verse text counted in Unicode code points, End is exclusive.
+type Span struct {
+	Type  string `bson:"type"`
+	Start int32  `bson:"start"`
+	End   int32  `bson:"end"`
+}
+
+// SpanText returns the text covered by the spans of the given type, joined
+// with spaces, i.e. only the words of Christ of a verse.
+func SpanText(text string, spans []Span, spanType string) string {
+	runes := []rune(text)
+	var parts []string
+	for _, span := range spans {
+		if span.Type != spanType || span.Start < 0 || span.End > int32(len(runes)) || span.Start >= span.End {
+			continue
+		}
+		parts = append(parts, strings.TrimSpace(string(runes[span.Start:span.End])))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,24 @@
+package wsbible
+
+import "testing"
+
+func TestSpanText(t *testing.T) {
+	// the offsets count code points, so the curly quotes are one each
+	text := "Jesus said, “Lazarus’ grave, roll the stone away.” Then the Jews said"
+	tests := []struct {
+		name  string
+		spans []Span
+		want  string
+	}{
+		{"one span", []Span{{SpanWordsOfChrist, 13, 49}}, "Lazarus’ grave, roll the stone away."},
+		{"spans joined", []Span{{SpanWordsOfChrist, 13, 28}, {SpanWordsOfChrist, 29, 49}}, "Lazarus’ grave, roll the stone away."},
+		{"other types skipped", []Span{{SpanAdded, 0, 5}, {SpanWordsOfChrist, 13, 20}}, "Lazarus"},
+		{"out of range skipped", []Span{{SpanWordsOfChrist, 60, 200}, {SpanWordsOfChrist, -1, 5}, {SpanWordsOfChrist, 9, 9}}, ""},
+		{"no spans", nil, ""},
+	}
+	for _, test := range tests {
+		if got := SpanText(text, test.spans, SpanWordsOfChrist); got != test.want {
+			t.Errorf("SpanText(%s) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
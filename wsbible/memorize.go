@@ -0,0 +1,272 @@
+package wsbible
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Memorization reviews are scheduled with the SM-2 algorithm: each recall is
+// graded 0 (forgotten) to 5 (perfect), a grade under 3 starts the passage
+// over and the ease factor grows or shrinks the gap to the next review.
+const (
+	// InitialEase is the ease factor of a new passage.
+	InitialEase = 2.5
+	// MinimumEase keeps hard passages from being reviewed every day forever.
+	MinimumEase = 1.3
+)
+
+// Review is the SM-2 schedule state of a memorized passage
+type Review struct {
+	Ease        float64
+	Interval    int32 // days until the next review
+	Repetitions int32 // recalls in a row graded 3 or better
+	Lapses      int32 // times the passage was forgotten after being learned
+}
+
+// Next returns the schedule after a recall of the given quality, 0 to 5
+func (r Review) Next(quality int32) Review {
+	if quality < 0 {
+		quality = 0
+	}
+	if quality > 5 {
+		quality = 5
+	}
+	if r.Ease == 0 {
+		r.Ease = InitialEase
+	}
+
+	if quality < 3 {
+		if r.Repetitions > 0 {
+			r.Lapses++
+		}
+		r.Repetitions = 0
+		r.Interval = 1
+	} else {
+		r.Repetitions++
+		switch r.Repetitions {
+		case 1:
+			r.Interval = 1
+		case 2:
+			r.Interval = 6
+		default:
+			r.Interval = int32(math.Round(float64(r.Interval) * r.Ease))
+		}
+	}
+
+	miss := float64(5 - quality)
+	r.Ease = math.Round((r.Ease+0.1-miss*(0.08+miss*0.02))*100) / 100
+	if r.Ease < MinimumEase {
+		r.Ease = MinimumEase
+	}
+	return r
+}
+
+// RecallQuality turns a recall score, the share of words remembered, into an
+// SM-2 grade
+func RecallQuality(score float64) int32 {
+	switch {
+	case score >= 0.98:
+		return 5
+	case score >= 0.9:
+		return 4
+	case score >= 0.75:
+		return 3
+	case score >= 0.5:
+		return 2
+	case score >= 0.25:
+		return 1
+	}
+	return 0
+}
+
+// Cloze hides about fraction of the words of text, chosen by seed, replacing
+// their letters with underscores and keeping the punctuation around them. It
+// returns the prompt and the hidden words in order. At least one word is
+// hidden when fraction is above 0.
+func Cloze(text string, fraction float64, seed int64) (string, []string) {
+	words := strings.Fields(text)
+	var candidates []int
+	for i, word := range words {
+		if recallKey(word) != "" {
+			candidates = append(candidates, i)
+		}
+	}
+	count := int(math.Round(fraction * float64(len(candidates))))
+	if count == 0 && fraction > 0 && len(candidates) > 0 {
+		count = 1
+	}
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+
+	hide := make(map[int]bool)
+	for _, n := range rand.New(rand.NewSource(seed)).Perm(len(candidates))[:count] {
+		hide[candidates[n]] = true
+	}
+	var hidden []string
+	for i, word := range words {
+		if !hide[i] {
+			continue
+		}
+		hidden = append(hidden, strings.TrimFunc(word, isPunctuation))
+		words[i] = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return '_'
+			}
+			return r
+		}, word)
+	}
+	return strings.Join(words, " "), hidden
+}
+
+// FirstLetters shortens each word of text to its first letter, keeping the
+// punctuation, i.e. "In the beginning God created" becomes "I t b G c"
+func FirstLetters(text string) string {
+	words := strings.Fields(text)
+	for i, word := range words {
+		var sb strings.Builder
+		letter := false
+		for _, r := range word {
+			switch {
+			case unicode.IsLetter(r) || unicode.IsDigit(r):
+				if !letter {
+					sb.WriteRune(r)
+					letter = true
+				}
+			case unicode.Is(unicode.Mn, r) || r == '\'' || r == '’':
+				// accents and apostrophes belong to the word
+			default:
+				sb.WriteRune(r)
+			}
+		}
+		words[i] = sb.String()
+	}
+	return strings.Join(words, " ")
+}
+
+// GradeRecall compares a typed recall with the text. Words match ignoring
+// case, punctuation and accents and allowing a typo or two in longer words,
+// and must come in order. The score is the Dice coefficient of the matched
+// words, so both missing and extra words lower it, and missed lists the words
+// of the text that were not recalled.
+func GradeRecall(text, answer string) (float64, []string) {
+	want := strings.Fields(text)
+	var wantKeys, gotKeys []string
+	var wantWords []string
+	for _, word := range want {
+		if key := recallKey(word); key != "" {
+			wantKeys = append(wantKeys, key)
+			wantWords = append(wantWords, strings.TrimFunc(word, isPunctuation))
+		}
+	}
+	for _, word := range strings.Fields(answer) {
+		if key := recallKey(word); key != "" {
+			gotKeys = append(gotKeys, key)
+		}
+	}
+	if len(wantKeys) == 0 {
+		return 1, nil
+	}
+
+	// longest common subsequence of fuzzily equal words
+	lcs := make([][]int, len(wantKeys)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(gotKeys)+1)
+	}
+	for i := len(wantKeys) - 1; i >= 0; i-- {
+		for j := len(gotKeys) - 1; j >= 0; j-- {
+			switch {
+			case similarWords(wantKeys[i], gotKeys[j]):
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var missed []string
+	i, j := 0, 0
+	for i < len(wantKeys) {
+		switch {
+		case j < len(gotKeys) && similarWords(wantKeys[i], gotKeys[j]) && lcs[i][j] == lcs[i+1][j+1]+1:
+			i, j = i+1, j+1
+		case j < len(gotKeys) && lcs[i][j+1] >= lcs[i+1][j]:
+			j++
+		default:
+			missed = append(missed, wantWords[i])
+			i++
+		}
+	}
+
+	matched := float64(lcs[0][0])
+	return 2 * matched / float64(len(wantKeys)+len(gotKeys)), missed
+}
+
+// recallKey folds a word for grading: lower case without accents or punctuation
+func recallKey(word string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, norm.NFD.String(word))
+}
+
+// isPunctuation reports whether r is not part of a word
+func isPunctuation(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// similarWords allows one typo in words of four or more letters and two in
+// words of eight or more
+func similarWords(a, b string) bool {
+	if a == b {
+		return true
+	}
+	allowed := 0
+	switch n := len([]rune(a)); {
+	case n >= 8:
+		allowed = 2
+	case n >= 4:
+		allowed = 1
+	}
+	return allowed > 0 && editDistance(a, b) <= allowed
+}
+
+// editDistance is the Levenshtein distance of two words
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
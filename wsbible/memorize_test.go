@@ -0,0 +1,134 @@
+package wsbible
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReviewNext(t *testing.T) {
+	// a passage learned, recalled with less and less ease, then forgotten
+	steps := []struct {
+		quality int32
+		want    Review
+	}{
+		{5, Review{Ease: 2.6, Interval: 1, Repetitions: 1}},
+		{5, Review{Ease: 2.7, Interval: 6, Repetitions: 2}},
+		{4, Review{Ease: 2.7, Interval: 16, Repetitions: 3}},
+		{3, Review{Ease: 2.56, Interval: 43, Repetitions: 4}},
+		{1, Review{Ease: 2.02, Interval: 1, Lapses: 1}},
+		{0, Review{Ease: 1.3, Interval: 1, Lapses: 1}}, // not a lapse again, and the ease bottoms out
+		{3, Review{Ease: 1.3, Interval: 1, Repetitions: 1, Lapses: 1}},
+	}
+	var review Review
+	for i, step := range steps {
+		review = review.Next(step.quality)
+		if review != step.want {
+			t.Fatalf("step %d, quality %d: got %+v, want %+v", i+1, step.quality, review, step.want)
+		}
+	}
+}
+
+func TestReviewNextClampsQuality(t *testing.T) {
+	start := Review{Ease: InitialEase, Interval: 6, Repetitions: 2}
+	if got, want := start.Next(9), start.Next(5); got != want {
+		t.Errorf("Next(9) = %+v, want Next(5) = %+v", got, want)
+	}
+	if got, want := start.Next(-1), start.Next(0); got != want {
+		t.Errorf("Next(-1) = %+v, want Next(0) = %+v", got, want)
+	}
+}
+
+func TestRecallQuality(t *testing.T) {
+	tests := []struct {
+		score float64
+		want  int32
+	}{
+		{1, 5}, {0.98, 5}, {0.95, 4}, {0.9, 4}, {0.8, 3}, {0.6, 2}, {0.3, 1}, {0.1, 0}, {0, 0},
+	}
+	for _, test := range tests {
+		if got := RecallQuality(test.score); got != test.want {
+			t.Errorf("RecallQuality(%v) = %d, want %d", test.score, got, test.want)
+		}
+	}
+}
+
+func TestGradeRecall(t *testing.T) {
+	genesis := "In the beginning God created the heaven and the earth."
+	tests := []struct {
+		name   string
+		text   string
+		answer string
+		score  float64
+		missed []string
+	}{
+		{"exact", genesis, genesis, 1, nil},
+		{"case and punctuation", genesis, "in the beginning, god created the heaven and the earth", 1, nil},
+		{"typos", genesis, "In the begining God creatd the heaven and the earth", 1, nil},
+		{"missing words", genesis, "In the beginning God created", 2 * 5.0 / 15, []string{"the", "heaven", "and", "the", "earth"}},
+		{"extra words", "Jesus wept.", "Jesus wept bitterly", 2 * 2.0 / 5, nil},
+		{"a wrong short word", "God is love.", "God as love", 2 * 2.0 / 6, []string{"is"}},
+		{"accents", "Ἐν ἀρχῇ ἦν ὁ λόγος", "εν αρχη ην ο λογος", 1, nil},
+		{"nothing recalled", "Jesus wept.", "", 0, []string{"Jesus", "wept"}},
+		{"no words to recall", "", "anything", 1, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			score, missed := GradeRecall(test.text, test.answer)
+			if score != test.score {
+				t.Errorf("score %v, want %v", score, test.score)
+			}
+			if !reflect.DeepEqual(missed, test.missed) {
+				t.Errorf("missed %q, want %q", missed, test.missed)
+			}
+		})
+	}
+}
+
+func TestCloze(t *testing.T) {
+	genesis := "In the beginning God created the heaven and the earth."
+	tests := []struct {
+		name     string
+		text     string
+		fraction float64
+		prompt   string
+		hidden   int
+	}{
+		{"nothing hidden", genesis, 0, genesis, 0},
+		{"everything hidden", "Jesus wept.", 1, "_____ ____.", 2},
+		{"at least one word", genesis, 0.01, "", 1},
+		{"half", genesis, 0.5, "", 5},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prompt, hidden := Cloze(test.text, test.fraction, 7)
+			if len(hidden) != test.hidden {
+				t.Errorf("hid %d words %q, want %d", len(hidden), hidden, test.hidden)
+			}
+			if test.prompt != "" && prompt != test.prompt {
+				t.Errorf("prompt %q, want %q", prompt, test.prompt)
+			}
+			again, _ := Cloze(test.text, test.fraction, 7)
+			if again != prompt {
+				t.Errorf("the same seed gave %q then %q", prompt, again)
+			}
+		})
+	}
+}
+
+func TestFirstLetters(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"In the beginning God created", "I t b G c"},
+		{"Jesus wept.", "J w."},
+		{"Don't be afraid; (Selah)", "D b a; (S)"},
+		{"Ἐν ἀρχῇ ἦν ὁ λόγος", "Ἐ ἀ ἦ ὁ λ"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if got := FirstLetters(test.text); got != test.want {
+			t.Errorf("FirstLetters(%q) = %q, want %q", test.text, got, test.want)
+		}
+	}
+}
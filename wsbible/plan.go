@@ -0,0 +1,199 @@
+package wsbible
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PlanTrack is one track of a reading plan, a readingplan document. A plan
+// is all the tracks with the same name, numbered from 1, read side by side
+// (the McCheyne plan has four readings a day).
+type PlanTrack struct {
+	Name   string   `json:"name"`
+	Number int32    `json:"number"`
+	Days   []string `json:"days"`
+}
+
+// PlanError is a reading of a plan that does not parse as a reference
+type PlanError struct {
+	Day     int32
+	Track   int32
+	Reading string
+	Err     error
+}
+
+func (e PlanError) Error() string {
+	return fmt.Sprintf("day %d reading %d %q: %v", e.Day, e.Track, e.Reading, e.Err)
+}
+
+// Plan formats for ReadPlan and WritePlan
+const (
+	PlanCSV  = "csv"
+	PlanJSON = "json"
+)
+
+// ReadPlan reads plan tracks in the format. CSV has no plan name so the
+// tracks are given name, for JSON a non blank name replaces the names in
+// the file.
+func ReadPlan(r io.Reader, format, name string) ([]PlanTrack, error) {
+	var tracks []PlanTrack
+	var err error
+	switch format {
+	case PlanCSV:
+		tracks, err = readPlanCSV(r, name)
+	case PlanJSON:
+		err = json.NewDecoder(r).Decode(&tracks)
+		if err == nil && name != "" {
+			for i := range tracks {
+				tracks[i].Name = name
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown plan format %q, use csv or json", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, track := range tracks {
+		if track.Name == "" {
+			return nil, fmt.Errorf("track %d has no plan name", track.Number)
+		}
+		if track.Number < 1 {
+			return nil, fmt.Errorf("plan %s has a track numbered %d, tracks are numbered from 1", track.Name, track.Number)
+		}
+	}
+	return tracks, nil
+}
+
+// readPlanCSV reads a plan with a header row and one row per day. A "day"
+// column holds the day number and every other column is a track, in order:
+//
+//	day,reading1,reading2,reading3,reading4
+//	1,Gen 1,Matt 1,Ezra 1,Acts 1
+func readPlanCSV(r io.Reader, name string) ([]PlanTrack, error) {
+	if name == "" {
+		return nil, fmt.Errorf("a CSV plan needs a name")
+	}
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) < 2 {
+		return nil, fmt.Errorf("a CSV plan needs a header row and at least one day")
+	}
+
+	dayColumn := -1
+	var tracks []PlanTrack
+	var columns []int
+	for i, header := range rows[0] {
+		if strings.EqualFold(strings.TrimSpace(header), "day") {
+			dayColumn = i
+			continue
+		}
+		tracks = append(tracks, PlanTrack{Name: name, Number: int32(len(tracks) + 1)})
+		columns = append(columns, i)
+	}
+	if len(tracks) == 0 {
+		return nil, fmt.Errorf("the CSV plan has no reading columns")
+	}
+
+	for i, row := range rows[1:] {
+		if dayColumn >= 0 {
+			day, err := strconv.Atoi(strings.TrimSpace(row[dayColumn]))
+			if err != nil || day != i+1 {
+				return nil, fmt.Errorf("row %d should be day %d, not %q", i+2, i+1, row[dayColumn])
+			}
+		}
+		for t, column := range columns {
+			tracks[t].Days = append(tracks[t].Days, strings.TrimSpace(row[column]))
+		}
+	}
+	return tracks, nil
+}
+
+// ValidatePlan parses every reading of the tracks, returning the ones that
+// are not references in day order. A reading may be several passages
+// separated by semicolons ("Ps 1; Prov 1") and blank readings are rest days.
+func ValidatePlan(tracks []PlanTrack) []PlanError {
+	var errs []PlanError
+	for _, track := range tracks {
+		for i, reading := range track.Days {
+			if reading == "" {
+				continue
+			}
+			for _, passage := range strings.Split(reading, ";") {
+				if _, err := ParseReference(passage); err != nil {
+					errs = append(errs, PlanError{Day: int32(i + 1), Track: track.Number, Reading: reading, Err: err})
+					break
+				}
+			}
+		}
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Day < errs[j].Day
+	})
+	return errs
+}
+
+// WritePlan writes plan tracks in the format, CSV holding only one plan
+func WritePlan(w io.Writer, format string, tracks []PlanTrack) error {
+	sorted := append([]PlanTrack(nil), tracks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Number < sorted[j].Number
+	})
+
+	switch format {
+	case PlanJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(sorted)
+	case PlanCSV:
+		return writePlanCSV(w, sorted)
+	}
+	return fmt.Errorf("unknown plan format %q, use csv or json", format)
+}
+
+func writePlanCSV(w io.Writer, tracks []PlanTrack) error {
+	days := 0
+	header := []string{"day"}
+	for _, track := range tracks {
+		if track.Name != tracks[0].Name {
+			return fmt.Errorf("a CSV plan holds one plan, found %s and %s", tracks[0].Name, track.Name)
+		}
+		if len(track.Days) > days {
+			days = len(track.Days)
+		}
+		header = append(header, "reading"+strconv.Itoa(int(track.Number)))
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for day := 0; day < days; day++ {
+		row := []string{strconv.Itoa(day + 1)}
+		for _, track := range tracks {
+			reading := ""
+			if day < len(track.Days) {
+				reading = track.Days[day]
+			}
+			row = append(row, reading)
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
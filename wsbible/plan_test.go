@@ -0,0 +1,103 @@
+package wsbible
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadPlan(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  string
+		plan   string
+		want   []PlanTrack
+		err    bool
+	}{
+		{
+			name:   "csv with a day column",
+			format: PlanCSV,
+			input:  "day,reading1,reading2\n1,Gen 1,Matt 1\n2, Gen 2 ,Matt 2\n",
+			plan:   "Test",
+			want: []PlanTrack{
+				{Name: "Test", Number: 1, Days: []string{"Gen 1", "Gen 2"}},
+				{Name: "Test", Number: 2, Days: []string{"Matt 1", "Matt 2"}},
+			},
+		},
+		{
+			name:   "csv without a day column",
+			format: PlanCSV,
+			input:  "reading\nPs 1\n",
+			plan:   "Test",
+			want:   []PlanTrack{{Name: "Test", Number: 1, Days: []string{"Ps 1"}}},
+		},
+		{name: "csv out of order days", format: PlanCSV, input: "day,reading\n2,Gen 1\n", plan: "Test", err: true},
+		{name: "csv without a name", format: PlanCSV, input: "day,reading\n1,Gen 1\n", err: true},
+		{name: "csv without days", format: PlanCSV, input: "day,reading\n", plan: "Test", err: true},
+		{
+			name:   "json renamed",
+			format: PlanJSON,
+			input:  `[{"name":"Old","number":1,"days":["Gen 1"]}]`,
+			plan:   "New",
+			want:   []PlanTrack{{Name: "New", Number: 1, Days: []string{"Gen 1"}}},
+		},
+		{name: "json track 0", format: PlanJSON, input: `[{"name":"Old","number":0,"days":["Gen 1"]}]`, err: true},
+		{name: "unknown format", format: "xml", input: "", plan: "Test", err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ReadPlan(strings.NewReader(test.input), test.format, test.plan)
+			if (err != nil) != test.err {
+				t.Fatalf("error %v, want error %v", err, test.err)
+			}
+			if !test.err && !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestValidatePlan(t *testing.T) {
+	tracks := []PlanTrack{
+		{Name: "Test", Number: 1, Days: []string{"Gen 1", "", "Gen 99", "Ps 1; Prov 1"}},
+		{Name: "Test", Number: 2, Days: []string{"Matt 1", "Hezekiah 1", "Mark 1", "Ps 1; Foo 2"}},
+	}
+	errs := ValidatePlan(tracks)
+	var got []string
+	for _, err := range errs {
+		got = append(got, err.Reading)
+	}
+	want := []string{"Hezekiah 1", "Gen 99", "Ps 1; Foo 2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("invalid readings %q, want %q in day order", got, want)
+	}
+}
+
+func TestWritePlanRoundTrip(t *testing.T) {
+	tracks := []PlanTrack{
+		{Name: "Test", Number: 2, Days: []string{"Matt 1", "Matt 2"}},
+		{Name: "Test", Number: 1, Days: []string{"Gen 1", "Gen 2"}},
+	}
+	for _, format := range []string{PlanCSV, PlanJSON} {
+		var buf bytes.Buffer
+		if err := WritePlan(&buf, format, tracks); err != nil {
+			t.Fatalf("WritePlan %s: %v", format, err)
+		}
+		got, err := ReadPlan(&buf, format, "Test")
+		if err != nil {
+			t.Fatalf("ReadPlan %s: %v", format, err)
+		}
+		want := []PlanTrack{tracks[1], tracks[0]}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s round trip got %+v, want %+v", format, got, want)
+		}
+	}
+
+	// a CSV file holds only one plan
+	two := []PlanTrack{{Name: "A", Number: 1}, {Name: "B", Number: 1}}
+	if err := WritePlan(&bytes.Buffer{}, PlanCSV, two); err == nil {
+		t.Errorf("WritePlan wrote two plans into one CSV")
+	}
+}
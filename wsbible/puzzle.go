@@ -0,0 +1,197 @@
+package wsbible
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Puzzle directions, each a step of row and column. Backwards placements
+// reverse them.
+var (
+	// DirectionAcross runs left to right.
+	DirectionAcross = [2]int{0, 1}
+	// DirectionDown runs top to bottom.
+	DirectionDown = [2]int{1, 0}
+	// DirectionDiagonal runs down and to the right.
+	DirectionDiagonal = [2]int{1, 1}
+	// DirectionDiagonalUp runs up and to the right.
+	DirectionDiagonalUp = [2]int{-1, 1}
+)
+
+// PuzzleDirections names the directions a puzzle can use
+var PuzzleDirections = map[string][][2]int{
+	"across":   {DirectionAcross},
+	"down":     {DirectionDown},
+	"diagonal": {DirectionDiagonal, DirectionDiagonalUp},
+}
+
+// stopwords are the common English words, including the archaic ones of the
+// KJV, left out of puzzles
+var stopwords = map[string]bool{}
+
+func init() {
+	for _, word := range strings.Fields(`a about above after again against all also am an and any are as at
+		be because been before being below between both but by can could did do does doing down during
+		each even ever every few for from further had has have having he her here hers herself him himself
+		his how i if in into is it its itself just let me more most my myself no nor not now of off on once
+		only or other our ours ourselves out over own same say said she should so some such than that the
+		their theirs them themselves then there these they this those through to too under until up upon
+		very was we were what when where which while who whom why will with would yet you your yours
+		yourself yourselves art doth dost hast hath shalt shall saith thee thou thy thine unto ye wilt
+		thereof therefore wherefore whosoever lest neither nay yea behold came come went go`) {
+		stopwords[word] = true
+	}
+}
+
+// PuzzleWords picks the significant words of a text for a puzzle: upper case
+// letters only, no stopwords or words shorter than minLength, each once. The
+// words used most come first, ties broken by length then alphabetically.
+func PuzzleWords(text string, minLength int) []string {
+	counts := make(map[string]int)
+	for _, field := range strings.Fields(text) {
+		word := strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) {
+				return unicode.ToUpper(r)
+			}
+			return -1
+		}, recallKey(field))
+		if len([]rune(word)) < minLength || stopwords[strings.ToLower(word)] {
+			continue
+		}
+		counts[word]++
+	}
+
+	var words []string
+	for word := range counts {
+		words = append(words, word)
+	}
+	sort.Slice(words, func(i, j int) bool {
+		a, b := words[i], words[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		if len([]rune(a)) != len([]rune(b)) {
+			return len([]rune(a)) > len([]rune(b))
+		}
+		return a < b
+	})
+	return words
+}
+
+// PlacedWord is a word hidden in a puzzle grid, from its first letter to its
+// last, rows and columns counted from 0 at the top left
+type PlacedWord struct {
+	Word      string
+	Row       int
+	Column    int
+	EndRow    int
+	EndColumn int
+}
+
+// placementTries is how many random spots a word is tried at before it is left out
+const placementTries = 500
+
+// GeneratePuzzle hides the words in a size by size grid in the directions,
+// also reversed when backwards is set, and fills the rest with letters of the
+// words. Words may cross where they share a letter. The same words, size,
+// directions and seed always give the same puzzle. Words that do not fit are
+// left out and returned.
+func GeneratePuzzle(words []string, size int, directions [][2]int, backwards bool, seed int64) ([][]rune, []PlacedWord, []string, error) {
+	if size < 1 {
+		return nil, nil, nil, fmt.Errorf("the grid needs at least one row")
+	}
+	if len(directions) == 0 {
+		return nil, nil, nil, fmt.Errorf("the puzzle needs at least one direction")
+	}
+	steps := append([][2]int{}, directions...)
+	if backwards {
+		for _, d := range directions {
+			steps = append(steps, [2]int{-d[0], -d[1]})
+		}
+	}
+
+	random := rand.New(rand.NewSource(seed))
+	grid := make([][]rune, size)
+	for row := range grid {
+		grid[row] = make([]rune, size)
+	}
+
+	// longest words first, they are the hardest to fit
+	order := append([]string{}, words...)
+	sort.SliceStable(order, func(i, j int) bool {
+		return len([]rune(order[i])) > len([]rune(order[j]))
+	})
+
+	var placed []PlacedWord
+	var skipped []string
+	var letters []rune
+	for _, word := range order {
+		letters = append(letters, []rune(word)...)
+		if p, ok := placeWord(grid, []rune(word), steps, random); ok {
+			p.Word = word
+			placed = append(placed, p)
+		} else {
+			skipped = append(skipped, word)
+		}
+	}
+
+	// fill the empty cells with letters the words use, so no script or
+	// letter frequency gives the words away
+	if len(letters) == 0 {
+		letters = []rune("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	}
+	for row := range grid {
+		for column := range grid[row] {
+			if grid[row][column] == 0 {
+				grid[row][column] = letters[random.Intn(len(letters))]
+			}
+		}
+	}
+
+	// list the solutions in the order the words were given
+	position := make(map[string]int)
+	for i, word := range words {
+		position[word] = i
+	}
+	sort.Slice(placed, func(i, j int) bool {
+		return position[placed[i].Word] < position[placed[j].Word]
+	})
+	return grid, placed, skipped, nil
+}
+
+// placeWord tries random spots and directions for a word, writing it into the
+// grid at the first that fits
+func placeWord(grid [][]rune, word []rune, steps [][2]int, random *rand.Rand) (PlacedWord, bool) {
+	size := len(grid)
+	if len(word) == 0 || len(word) > size {
+		return PlacedWord{}, false
+	}
+	for try := 0; try < placementTries; try++ {
+		step := steps[random.Intn(len(steps))]
+		row, column := random.Intn(size), random.Intn(size)
+		endRow, endColumn := row+step[0]*(len(word)-1), column+step[1]*(len(word)-1)
+		if endRow < 0 || endRow >= size || endColumn < 0 || endColumn >= size {
+			continue
+		}
+
+		fits := true
+		for i, r := range word {
+			cell := grid[row+step[0]*i][column+step[1]*i]
+			if cell != 0 && cell != r {
+				fits = false
+				break
+			}
+		}
+		if !fits {
+			continue
+		}
+		for i, r := range word {
+			grid[row+step[0]*i][column+step[1]*i] = r
+		}
+		return PlacedWord{Row: row, Column: column, EndRow: endRow, EndColumn: endColumn}, true
+	}
+	return PlacedWord{}, false
+}
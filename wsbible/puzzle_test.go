@@ -0,0 +1,102 @@
+package wsbible
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPuzzleWords(t *testing.T) {
+	tests := []struct {
+		text      string
+		minLength int
+		want      []string
+	}{
+		{"In the beginning God created the heaven and the earth.", 4, []string{"BEGINNING", "CREATED", "HEAVEN", "EARTH"}},
+		{"And God said, Let there be light: and there was light. God saw the light", 3, []string{"LIGHT", "GOD", "SAW"}},
+		{"Ἐν ἀρχῇ ἦν ὁ λόγος", 4, []string{"ΛΟΓΟΣ", "ΑΡΧΗ"}},
+		{"Thou shalt not", 3, nil},
+	}
+	for _, test := range tests {
+		got := PuzzleWords(test.text, test.minLength)
+		if len(got) == 0 && len(test.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("PuzzleWords(%q, %d) = %q, want %q", test.text, test.minLength, got, test.want)
+		}
+	}
+}
+
+func TestGeneratePuzzle(t *testing.T) {
+	words := []string{"BEGINNING", "CREATED", "HEAVEN", "EARTH", "GOD"}
+	tests := []struct {
+		name       string
+		size       int
+		directions [][2]int
+		backwards  bool
+		skipped    []string
+	}{
+		{"across", 12, PuzzleDirections["across"], false, nil},
+		{"down and backwards", 12, PuzzleDirections["down"], true, nil},
+		{"diagonal", 15, PuzzleDirections["diagonal"], true, nil},
+		{"too small", 7, [][2]int{DirectionAcross, DirectionDown}, false, []string{"BEGINNING"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			grid, placed, skipped, err := GeneratePuzzle(words, test.size, test.directions, test.backwards, 42)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(skipped, test.skipped) {
+				t.Errorf("skipped %q, want %q", skipped, test.skipped)
+			}
+			if len(placed)+len(skipped) != len(words) {
+				t.Errorf("placed %d and skipped %d of %d words", len(placed), len(skipped), len(words))
+			}
+
+			allowed := make(map[[2]int]bool)
+			for _, d := range test.directions {
+				allowed[d] = true
+				if test.backwards {
+					allowed[[2]int{-d[0], -d[1]}] = true
+				}
+			}
+			for _, p := range placed {
+				// the solution spells the word along an allowed direction
+				length := len([]rune(p.Word)) - 1
+				step := [2]int{(p.EndRow - p.Row) / length, (p.EndColumn - p.Column) / length}
+				if !allowed[step] {
+					t.Errorf("%s runs %v, not an allowed direction", p.Word, step)
+				}
+				var spelled []rune
+				for i := 0; i <= length; i++ {
+					spelled = append(spelled, grid[p.Row+step[0]*i][p.Column+step[1]*i])
+				}
+				if string(spelled) != p.Word {
+					t.Errorf("%s reads %s in the grid", p.Word, string(spelled))
+				}
+			}
+			for _, row := range grid {
+				for _, cell := range row {
+					if cell == 0 {
+						t.Fatalf("the grid has an empty cell")
+					}
+				}
+			}
+
+			again, _, _, _ := GeneratePuzzle(words, test.size, test.directions, test.backwards, 42)
+			if !reflect.DeepEqual(again, grid) {
+				t.Errorf("the same seed gave a different grid")
+			}
+		})
+	}
+}
+
+func TestGeneratePuzzleRejects(t *testing.T) {
+	if _, _, _, err := GeneratePuzzle([]string{"GOD"}, 0, PuzzleDirections["across"], false, 1); err == nil {
+		t.Errorf("a grid of size 0 was generated")
+	}
+	if _, _, _, err := GeneratePuzzle([]string{"GOD"}, 5, nil, false, 1); err == nil {
+		t.Errorf("a grid without directions was generated")
+	}
+}
@@ -0,0 +1,105 @@
+package wsbible
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// BookDistractors picks count wrong answers for a "which book is this verse
+// from" question: books of the same genre first, so the choices are close,
+// then the books nearest in the canon.
+func BookDistractors(book int32, count int, random *rand.Rand) []int32 {
+	genre := BookGenre(book)
+	var same, other []int32
+	for _, b := range Books {
+		switch {
+		case b.Number == book:
+		case BookGenre(b.Number) == genre:
+			same = append(same, b.Number)
+		default:
+			other = append(other, b.Number)
+		}
+	}
+	random.Shuffle(len(same), func(i, j int) { same[i], same[j] = same[j], same[i] })
+	sort.SliceStable(other, func(i, j int) bool {
+		return distance(other[i], book) < distance(other[j], book)
+	})
+
+	picked := append(same, other...)
+	if len(picked) > count {
+		picked = picked[:count]
+	}
+	return picked
+}
+
+func distance(a, b int32) int32 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// BlankVerse blanks out one significant word of a verse for a fill in the
+// blank question, chosen by random. It returns the prompt and the word, lower
+// case, or false when the verse has no word of minLength letters to blank.
+func BlankVerse(text string, minLength int, random *rand.Rand) (string, string, bool) {
+	candidates := PuzzleWords(text, minLength)
+	if len(candidates) == 0 {
+		return "", "", false
+	}
+	// PuzzleWords orders by use, sort so the pick does not favor common words
+	sort.Strings(candidates)
+	word := candidates[random.Intn(len(candidates))]
+
+	fields := strings.Fields(text)
+	var answer string
+	for i, field := range fields {
+		if strings.ToUpper(recallKey(field)) != word {
+			continue
+		}
+		answer = strings.ToLower(strings.TrimFunc(field, isPunctuation))
+		start := strings.IndexFunc(field, func(r rune) bool { return !isPunctuation(r) })
+		end := strings.LastIndexFunc(field, func(r rune) bool { return !isPunctuation(r) })
+		_, size := utf8.DecodeRuneInString(field[end:])
+		fields[i] = field[:start] + "_____" + field[end+size:]
+		break
+	}
+	if answer == "" {
+		return "", "", false
+	}
+	return strings.Join(fields, " "), answer, true
+}
+
+// WordDistractors picks count wrong answers for a fill in the blank question
+// from a pool of words, lower case, preferring words about as long as the
+// answer so the length does not give it away.
+func WordDistractors(answer string, pool []string, count int, random *rand.Rand) []string {
+	key := recallKey(answer)
+	seen := map[string]bool{key: true}
+	var words []string
+	for _, word := range pool {
+		word = strings.ToLower(word)
+		if k := recallKey(word); !seen[k] {
+			seen[k] = true
+			words = append(words, word)
+		}
+	}
+	sort.Strings(words)
+	random.Shuffle(len(words), func(i, j int) { words[i], words[j] = words[j], words[i] })
+	length := len([]rune(key))
+	sort.SliceStable(words, func(i, j int) bool {
+		return distance(int32(len([]rune(words[i]))), int32(length)) < distance(int32(len([]rune(words[j]))), int32(length))
+	})
+	if len(words) > count {
+		words = words[:count]
+	}
+	return words
+}
+
+// MatchesWord reports whether a typed answer is the word, ignoring case,
+// punctuation and accents and allowing the typos GradeRecall allows
+func MatchesWord(answer, word string) bool {
+	return similarWords(recallKey(word), recallKey(answer))
+}
@@ -0,0 +1,108 @@
+package wsbible
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestBookGenre(t *testing.T) {
+	tests := []struct {
+		book int32
+		want string
+	}{
+		{1, GenreLaw}, {5, GenreLaw}, {6, GenreHistory}, {19, GenreWisdom}, {23, GenreMajorProphets},
+		{28, GenreMinorProphets}, {40, GenreGospels}, {44, GenreHistory}, {45, GenrePaulineEpistles},
+		{58, GenreGeneralEpistles}, {66, GenreApocalyptic}, {0, ""}, {67, ""},
+	}
+	for _, test := range tests {
+		if got := BookGenre(test.book); got != test.want {
+			t.Errorf("BookGenre(%d) = %q, want %q", test.book, got, test.want)
+		}
+	}
+}
+
+func TestBookDistractors(t *testing.T) {
+	tests := []struct {
+		name  string
+		book  int32
+		count int
+		same  []int32 // the books of the genre, in any order
+		rest  []int32 // then the nearest books, in order
+	}{
+		{"the genre only", 40, 3, []int32{41, 42, 43}, nil},
+		{"then the nearest books", 40, 5, []int32{41, 42, 43}, []int32{39, 38}},
+		{"a genre of one book", 66, 2, nil, []int32{65, 64}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := BookDistractors(test.book, test.count, rand.New(rand.NewSource(1)))
+			if len(got) != test.count {
+				t.Fatalf("got %d books, want %d", len(got), test.count)
+			}
+			same := append([]int32{}, got[:len(test.same)]...)
+			sort.Slice(same, func(i, j int) bool { return same[i] < same[j] })
+			if len(test.same) > 0 && !reflect.DeepEqual(same, test.same) {
+				t.Errorf("genre books %v, want %v", got[:len(test.same)], test.same)
+			}
+			if rest := got[len(test.same):]; len(test.rest) > 0 && !reflect.DeepEqual(rest, test.rest) {
+				t.Errorf("nearest books %v, want %v", rest, test.rest)
+			}
+		})
+	}
+}
+
+func TestBlankVerse(t *testing.T) {
+	tests := []struct {
+		text    string
+		prompts map[string]string // the possible prompts and their answers
+	}{
+		{"Jesus wept.", map[string]string{"_____ wept.": "jesus", "Jesus _____.": "wept"}},
+		{"\"Rejoice!\"", map[string]string{"\"_____!\"": "rejoice"}},
+		{"I am", nil},
+	}
+	for _, test := range tests {
+		for seed := int64(0); seed < 10; seed++ {
+			prompt, answer, ok := BlankVerse(test.text, 4, rand.New(rand.NewSource(seed)))
+			if ok != (test.prompts != nil) {
+				t.Fatalf("BlankVerse(%q) ok %v", test.text, ok)
+			}
+			if ok && test.prompts[prompt] != answer {
+				t.Errorf("BlankVerse(%q) = %q, %q, not one of %q", test.text, prompt, answer, test.prompts)
+			}
+		}
+	}
+}
+
+func TestWordDistractors(t *testing.T) {
+	pool := []string{"Light", "darkness", "day", "night", "waters", "LIGHT"}
+	got := WordDistractors("light", pool, 2, rand.New(rand.NewSource(1)))
+	if want := []string{"night", "waters"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WordDistractors = %q, want %q, the closest lengths without the answer", got, want)
+	}
+	if got := WordDistractors("light", pool, 10, rand.New(rand.NewSource(1))); len(got) != 4 {
+		t.Errorf("WordDistractors gave %q, want the 4 other words", got)
+	}
+}
+
+func TestMatchesWord(t *testing.T) {
+	tests := []struct {
+		answer, word string
+		want         bool
+	}{
+		{"Beginning", "beginning", true},
+		{"begining", "beginning", true},
+		{"light!", "Light", true},
+		{"λογος", "λόγος", true},
+		{"way", "was", false}, // no typos in short words
+		{"night", "light", true},
+		{"darkness", "light", false},
+		{"", "light", false},
+	}
+	for _, test := range tests {
+		if got := MatchesWord(test.answer, test.word); got != test.want {
+			t.Errorf("MatchesWord(%q, %q) = %v, want %v", test.answer, test.word, got, test.want)
+		}
+	}
+}
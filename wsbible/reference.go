@@ -0,0 +1,192 @@
+package wsbible
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Reference is a passage of Scripture from Book Chapter:Verse through
+// EndBook EndChapter:EndVerse inclusive. A Verse of 0 means the passage
+// starts at the beginning of the chapter and an EndVerse of 0 means it
+// runs to the end of EndChapter.
+type Reference struct {
+	Book       int32
+	Chapter    int32
+	Verse      int32
+	EndBook    int32
+	EndChapter int32
+	EndVerse   int32
+}
+
+// ParseOSIS parses an OSIS reference such as "Gen.1.1", "Gen.1" or
+// "Prov.8.22-Prov.8.30", the format used by the OpenBible.info and
+// Treasury of Scripture Knowledge cross reference datasets.
+func ParseOSIS(osisRef string) (Reference, error) {
+	var ref Reference
+	parts := strings.SplitN(strings.TrimSpace(osisRef), "-", 2)
+
+	start, err := parseOSISPoint(parts[0])
+	if err != nil {
+		return ref, err
+	}
+	end := start
+	if len(parts) == 2 {
+		if end, err = parseOSISPoint(parts[1]); err != nil {
+			return ref, err
+		}
+	}
+
+	ref = Reference{
+		Book:       start[0],
+		Chapter:    start[1],
+		Verse:      start[2],
+		EndBook:    end[0],
+		EndChapter: end[1],
+		EndVerse:   end[2],
+	}
+	if ref.EndBook < ref.Book ||
+		(ref.EndBook == ref.Book && ref.EndChapter < ref.Chapter) ||
+		(ref.EndBook == ref.Book && ref.EndChapter == ref.Chapter && ref.EndVerse != 0 && ref.EndVerse < ref.Verse) {
+		return ref, fmt.Errorf("reference %q ends before it starts", osisRef)
+	}
+	return ref, nil
+}
+
+// parseOSISPoint parses a single "Book.Chapter.Verse" or "Book.Chapter" id
+// into book, chapter and verse numbers.
+func parseOSISPoint(point string) ([3]int32, error) {
+	var p [3]int32
+	fields := strings.Split(point, ".")
+	if len(fields) < 2 || len(fields) > 3 {
+		return p, fmt.Errorf("invalid OSIS reference %q", point)
+	}
+	book, ok := BookByOSIS(fields[0])
+	if !ok {
+		return p, fmt.Errorf("unknown OSIS book %q", fields[0])
+	}
+	p[0] = book.Number
+	for i, field := range fields[1:] {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 1 {
+			return p, fmt.Errorf("invalid OSIS reference %q", point)
+		}
+		p[i+1] = int32(n)
+	}
+	return p, nil
+}
+
+// humanReference matches a reference as people write it, a book name followed
+// by chapter[:verse], optionally a dash and [book] chapter[:verse] or a verse
+var humanReference = regexp.MustCompile(`^((?:[1-3]\s*)?[^\d\s][^\d]*?)\s*(\d+)(?::(\d+))?` +
+	`(?:\s*[-\x{2013}]\s*((?:[1-3]\s*)?[^\d\s][^\d]*?)?\s*(\d+)(?::(\d+))?)?$`)
+
+// ParseReference parses a reference as people write it, the format of
+// reading plans: "Genesis 1", "Gen 1-3", "Ps 119:1-24", "Matt 5:1-7:29",
+// "1 Jn 2:1" or "Mal 4-Matt 1". Book names are anything BookByName knows,
+// and chapters are checked against the book.
+func ParseReference(text string) (Reference, error) {
+	var ref Reference
+	m := humanReference.FindStringSubmatch(strings.TrimSpace(text))
+	if m == nil {
+		return ref, fmt.Errorf("invalid reference %q", text)
+	}
+
+	book, ok := BookByName(m[1])
+	if !ok {
+		return ref, fmt.Errorf("unknown book %q", strings.TrimSpace(m[1]))
+	}
+	// in single chapter books "Jude 3" is a verse, but "Jude 1" is the book
+	if book.Chapters == 1 && m[3] == "" && (m[2] != "1" || (m[5] != "" && m[4] == "")) {
+		m[2], m[3] = "1", m[2]
+	}
+	ref.Book = book.Number
+	ref.Chapter = atoi32(m[2])
+	ref.Verse = atoi32(m[3])
+	ref.EndBook, ref.EndChapter, ref.EndVerse = ref.Book, ref.Chapter, ref.Verse
+
+	switch {
+	case m[5] == "":
+		// a single chapter or verse
+	case m[4] != "":
+		endBook, ok := BookByName(m[4])
+		if !ok {
+			return ref, fmt.Errorf("unknown book %q", strings.TrimSpace(m[4]))
+		}
+		ref.EndBook, ref.EndChapter, ref.EndVerse = endBook.Number, atoi32(m[5]), atoi32(m[6])
+	case m[6] != "":
+		ref.EndChapter, ref.EndVerse = atoi32(m[5]), atoi32(m[6])
+	case ref.Verse > 0:
+		// Ps 119:1-24 ends on a verse of the same chapter
+		ref.EndVerse = atoi32(m[5])
+	default:
+		ref.EndChapter = atoi32(m[5])
+	}
+	// a range of whole chapters ends at the end of its last chapter
+	if ref.Verse == 0 {
+		ref.EndVerse = 0
+	}
+
+	for _, point := range [][2]int32{{ref.Book, ref.Chapter}, {ref.EndBook, ref.EndChapter}} {
+		b, _ := BookByNumber(point[0])
+		if point[1] < 1 || point[1] > b.Chapters {
+			return ref, fmt.Errorf("%s has %d chapters, not %d", b.Name, b.Chapters, point[1])
+		}
+	}
+	if ref.EndBook < ref.Book ||
+		(ref.EndBook == ref.Book && ref.EndChapter < ref.Chapter) ||
+		(ref.EndBook == ref.Book && ref.EndChapter == ref.Chapter && ref.EndVerse != 0 && ref.EndVerse < ref.Verse) {
+		return ref, fmt.Errorf("reference %q ends before it starts", text)
+	}
+	return ref, nil
+}
+
+// atoi32 converts a matched number, blank being 0
+func atoi32(s string) int32 {
+	n, _ := strconv.Atoi(s)
+	return int32(n)
+}
+
+// String formats the reference for display, e.g. "Genesis 1:1-3",
+// "Genesis 1:31-2:3", "Psalms 23" or "Malachi 4:6-Matthew 1:1".
+func (r Reference) String() string {
+	book, ok := BookByNumber(r.Book)
+	if !ok {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(book.Name)
+	sb.WriteString(" ")
+	sb.WriteString(strconv.Itoa(int(r.Chapter)))
+	if r.Verse > 0 {
+		sb.WriteString(":")
+		sb.WriteString(strconv.Itoa(int(r.Verse)))
+	}
+
+	switch {
+	case r.EndBook != r.Book:
+		if endBook, ok := BookByNumber(r.EndBook); ok {
+			sb.WriteString("-")
+			sb.WriteString(endBook.Name)
+			sb.WriteString(" ")
+			sb.WriteString(strconv.Itoa(int(r.EndChapter)))
+			if r.EndVerse > 0 {
+				sb.WriteString(":")
+				sb.WriteString(strconv.Itoa(int(r.EndVerse)))
+			}
+		}
+	case r.EndChapter != r.Chapter:
+		sb.WriteString("-")
+		sb.WriteString(strconv.Itoa(int(r.EndChapter)))
+		if r.EndVerse > 0 {
+			sb.WriteString(":")
+			sb.WriteString(strconv.Itoa(int(r.EndVerse)))
+		}
+	case r.EndVerse != r.Verse && r.EndVerse > 0:
+		sb.WriteString("-")
+		sb.WriteString(strconv.Itoa(int(r.EndVerse)))
+	}
+	return sb.String()
+}
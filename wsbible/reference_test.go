@@ -0,0 +1,78 @@
+package wsbible
+
+import "testing"
+
+func TestParseOSIS(t *testing.T) {
+	tests := []struct {
+		osis string
+		want Reference
+		err  bool
+	}{
+		{"Gen.1.1", Reference{1, 1, 1, 1, 1, 1}, false},
+		{"Gen.1", Reference{1, 1, 0, 1, 1, 0}, false},
+		{"Prov.8.22-Prov.8.30", Reference{20, 8, 22, 20, 8, 30}, false},
+		{"Gen.1.31-Gen.2.3", Reference{1, 1, 31, 1, 2, 3}, false},
+		{"Mal.4.6-Matt.1.1", Reference{39, 4, 6, 40, 1, 1}, false},
+		{"1Cor.13.4", Reference{46, 13, 4, 46, 13, 4}, false},
+		{"Gen.2.1-Gen.1.1", Reference{}, true},
+		{"Foo.1.1", Reference{}, true},
+		{"Gen", Reference{}, true},
+		{"Gen.0.1", Reference{}, true},
+	}
+	for _, test := range tests {
+		got, err := ParseOSIS(test.osis)
+		if (err != nil) != test.err {
+			t.Errorf("ParseOSIS(%q) error %v, want error %v", test.osis, err, test.err)
+			continue
+		}
+		if !test.err && got != test.want {
+			t.Errorf("ParseOSIS(%q) = %+v, want %+v", test.osis, got, test.want)
+		}
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		text   string
+		want   Reference
+		string string // how the reference formats back
+		err    bool
+	}{
+		{"Genesis 1", Reference{1, 1, 0, 1, 1, 0}, "Genesis 1", false},
+		{"Gen 1-3", Reference{1, 1, 0, 1, 3, 0}, "Genesis 1-3", false},
+		{"Ps 119:1-24", Reference{19, 119, 1, 19, 119, 24}, "Psalms 119:1-24", false},
+		{"Matt 5:1-7:29", Reference{40, 5, 1, 40, 7, 29}, "Matthew 5:1-7:29", false},
+		{"1 Jn 2:1", Reference{62, 2, 1, 62, 2, 1}, "1 John 2:1", false},
+		{"Mal 4-Matt 1", Reference{39, 4, 0, 40, 1, 0}, "Malachi 4-Matthew 1", false},
+		{"Mal 4:6 – Matt 1:1", Reference{39, 4, 6, 40, 1, 1}, "Malachi 4:6-Matthew 1:1", false},
+		{"Jude 3", Reference{65, 1, 3, 65, 1, 3}, "Jude 1:3", false},
+		{"Jude 1", Reference{65, 1, 0, 65, 1, 0}, "Jude 1", false},
+		{"Jude 3-5", Reference{65, 1, 3, 65, 1, 5}, "Jude 1:3-5", false},
+		{"Gen 51", Reference{}, "", true},
+		{"Gen 3-1", Reference{}, "", true},
+		{"Ps 23:6-1", Reference{}, "", true},
+		{"Hezekiah 1", Reference{}, "", true},
+		{"", Reference{}, "", true},
+	}
+	for _, test := range tests {
+		got, err := ParseReference(test.text)
+		if (err != nil) != test.err {
+			t.Errorf("ParseReference(%q) error %v, want error %v", test.text, err, test.err)
+			continue
+		}
+		if test.err {
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseReference(%q) = %+v, want %+v", test.text, got, test.want)
+		}
+		if got.String() != test.string {
+			t.Errorf("ParseReference(%q).String() = %q, want %q", test.text, got.String(), test.string)
+		}
+		// the formatted reference parses back to the same passage
+		again, err := ParseReference(got.String())
+		if err != nil || again != got {
+			t.Errorf("ParseReference(%q) = %+v, %v, want %+v", got.String(), again, err, got)
+		}
+	}
+}
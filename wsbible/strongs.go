@@ -0,0 +1,33 @@
+package wsbible
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NormalizeStrongs returns the canonical form of a Strong's number, an upper
+// case H (Hebrew) or G (Greek) prefix and the number without leading zeros,
+// keeping any lower case extension letter: "h02617" becomes "H2617" and
+// "H1254a" stays "H1254a".
+func NormalizeStrongs(number string) (string, error) {
+	number = strings.TrimSpace(number)
+	if len(number) < 2 {
+		return "", fmt.Errorf("invalid Strong's number %q", number)
+	}
+
+	prefix := strings.ToUpper(number[:1])
+	if prefix != "H" && prefix != "G" {
+		return "", fmt.Errorf("Strong's number %q must start with H or G", number)
+	}
+	digits := number[1:]
+	suffix := ""
+	if last := digits[len(digits)-1]; last < '0' || last > '9' {
+		digits, suffix = digits[:len(digits)-1], strings.ToLower(string(last))
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil || n < 1 {
+		return "", fmt.Errorf("invalid Strong's number %q", number)
+	}
+	return prefix + strconv.Itoa(n) + suffix, nil
+}
@@ -0,0 +1,33 @@
+package wsbible
+
+import "testing"
+
+func TestNormalizeStrongs(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+		err    bool
+	}{
+		{"H2617", "H2617", false},
+		{"h02617", "H2617", false},
+		{" G26 ", "G26", false},
+		{"H1254a", "H1254a", false},
+		{"h1254A", "H1254a", false},
+		{"G0", "", true},
+		{"X26", "", true},
+		{"H", "", true},
+		{"Ha", "", true},
+		{"G2x6", "", true},
+		{"", "", true},
+	}
+	for _, test := range tests {
+		got, err := NormalizeStrongs(test.number)
+		if (err != nil) != test.err {
+			t.Errorf("NormalizeStrongs(%q) error %v, want error %v", test.number, err, test.err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("NormalizeStrongs(%q) = %q, want %q", test.number, got, test.want)
+		}
+	}
+}
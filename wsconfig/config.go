@@ -0,0 +1,407 @@
+// Package wsconfig is the configuration of the wordsearcher server and the
+// ws_import tool: where to listen, which backend and database to use, the
+// collection names, message size limits, timeouts and feature toggles.
+//
+// Every setting has a default, and is overridden in this order, the last
+// one winning:
+//
+//  1. the YAML file named by -config or WS_CONFIG, see config.example.yaml
+//  2. the environment, WS_ and the flag name in upper case with underscores,
+//     i.e. WS_GATEWAY_ADDRESS or WS_COLLECTION_VERSE, and MONGOURI for the
+//     MongoDB connection string as before
+//  3. the command line flags, i.e. -gateway-address or -collection-verse
+//
+// The settings are validated once they are all applied, and every problem
+// found is reported together.
+package wsconfig
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Backends are the storage backends the server can run on
+var Backends = []string{"mongo"}
+
+// Config is the configuration of the server, with the YAML keys of the config file
+type Config struct {
+	Address        string `yaml:"address"`         // the gRPC server
+	GatewayAddress string `yaml:"gateway_address"` // the REST gateway and gRPC-Web
+	TLS            TLS    `yaml:"tls"`             // of the gRPC server, plaintext when not set
+
+	Backend     string      `yaml:"backend"`
+	MongoURI    string      `yaml:"mongo_uri"`
+	Database    string      `yaml:"database"`
+	Collections Collections `yaml:"collections"`
+
+	MaxRecvMsgSize int `yaml:"max_recv_msg_size"` // bytes
+	MaxSendMsgSize int `yaml:"max_send_msg_size"` // bytes
+
+	ConnectTimeout      time.Duration `yaml:"connect_timeout"`
+	RequestTimeout      time.Duration `yaml:"request_timeout"` // 0 for no deadline
+	HealthCheckInterval time.Duration `yaml:"health_check_interval"`
+	HealthCheckTimeout  time.Duration `yaml:"health_check_timeout"`
+	ShutdownTimeout     time.Duration `yaml:"shutdown_timeout"` // drain time before calls are cut off
+
+	CORSOrigins []string `yaml:"cors_origins"` // "*" allows any origin, without credentials
+	TokenSecret string   `yaml:"token_secret"` // random when empty, tokens do not survive a restart
+
+	Features Features `yaml:"features"`
+}
+
+// Collections are the names of the collections in the database
+type Collections struct {
+	Verse          string `yaml:"verse"`
+	ReadingPlan    string `yaml:"readingplan"`
+	CustomRange    string `yaml:"customrange"`
+	Translation    string `yaml:"translation"`
+	Pericope       string `yaml:"pericope"`
+	CrossReference string `yaml:"crossreference"`
+	Footnote       string `yaml:"footnote"`
+	Interlinear    string `yaml:"interlinear"`
+	Lexicon        string `yaml:"lexicon"`
+	User           string `yaml:"user"`
+	Annotation     string `yaml:"annotation"`
+	VerseList      string `yaml:"verselist"`
+	Enrollment     string `yaml:"enrollment"`
+	MemoryCard     string `yaml:"memorycard"`
+}
+
+// TLS are the certificate files of the gRPC server. The files are watched
+// and reloaded when they change, so certificates rotate without a restart.
+type TLS struct {
+	CertFile       string        `yaml:"cert_file"`
+	KeyFile        string        `yaml:"key_file"`
+	ClientCAFile   string        `yaml:"client_ca_file"` // clients need a certificate it signed, mutual TLS
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+// Enabled reports whether the gRPC server uses TLS
+func (t TLS) Enabled() bool {
+	return t.CertFile != ""
+}
+
+// Features turn the optional parts of the server on and off
+type Features struct {
+	Gateway    bool `yaml:"gateway"`    // the REST/JSON gateway
+	GRPCWeb    bool `yaml:"grpc_web"`   // gRPC-Web for browsers
+	Docs       bool `yaml:"docs"`       // the OpenAPI document and API explorer, part of the gateway
+	Reflection bool `yaml:"reflection"` // gRPC server reflection for grpcurl
+}
+
+// Default returns the configuration used when nothing is set
+func Default() *Config {
+	return &Config{
+		Address:        "0.0.0.0:50051",
+		GatewayAddress: "0.0.0.0:8080",
+		TLS: TLS{
+			ReloadInterval: time.Minute,
+		},
+		Backend:  "mongo",
+		Database: "myFirstDatabase",
+		Collections: Collections{
+			Verse:          "verse",
+			ReadingPlan:    "readingplan",
+			CustomRange:    "customrange",
+			Translation:    "translation",
+			Pericope:       "pericope",
+			CrossReference: "crossreference",
+			Footnote:       "footnote",
+			Interlinear:    "interlinear",
+			Lexicon:        "lexicon",
+			User:           "user",
+			Annotation:     "annotation",
+			VerseList:      "verselist",
+			Enrollment:     "enrollment",
+			MemoryCard:     "memorycard",
+		},
+		MaxRecvMsgSize:      4 << 20,
+		MaxSendMsgSize:      4 << 20,
+		ConnectTimeout:      10 * time.Second,
+		RequestTimeout:      30 * time.Second,
+		HealthCheckInterval: 15 * time.Second,
+		HealthCheckTimeout:  5 * time.Second,
+		ShutdownTimeout:     30 * time.Second,
+		CORSOrigins:         []string{"http://localhost:4200"}, // the Angular development server
+		Features: Features{
+			Gateway:    true,
+			GRPCWeb:    true,
+			Docs:       true,
+			Reflection: true,
+		},
+	}
+}
+
+// collection is a collection name setting, keyed as in the file
+type collection struct {
+	key  string
+	name *string
+}
+
+// collections lists the collection names with their keys
+func (c *Collections) collections() []collection {
+	return []collection{
+		{"verse", &c.Verse},
+		{"readingplan", &c.ReadingPlan},
+		{"customrange", &c.CustomRange},
+		{"translation", &c.Translation},
+		{"pericope", &c.Pericope},
+		{"crossreference", &c.CrossReference},
+		{"footnote", &c.Footnote},
+		{"interlinear", &c.Interlinear},
+		{"lexicon", &c.Lexicon},
+		{"user", &c.User},
+		{"annotation", &c.Annotation},
+		{"verselist", &c.VerseList},
+		{"enrollment", &c.Enrollment},
+		{"memorycard", &c.MemoryCard},
+	}
+}
+
+// Load reads the configuration of the program name from the defaults, the
+// config file, the environment and the flags in args, in that order, and
+// validates it. A nil args reads no flags, as for ws_import whose sub
+// commands have their own.
+func Load(name string, args []string) (*Config, error) {
+	// a first pass only finds the config file, the flags are parsed again
+	// once the file and environment are applied so they take precedence
+	path := os.Getenv("WS_CONFIG")
+	first := Default().flagSet(name)
+	first.StringVar(&path, "config", path, "YAML config file (WS_CONFIG)")
+	if err := first.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg := Default()
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	flags := cfg.flagSet(name)
+	flags.String("config", path, "YAML config file (WS_CONFIG)")
+	flags.SetOutput(ioutil.Discard)
+	if err := cfg.loadEnv(flags); err != nil {
+		return nil, err
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// loadFile applies a YAML config file, rejecting keys it does not know
+func (c *Config) loadFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading the config file: %v", err)
+	}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return fmt.Errorf("error in the config file %s: %v", path, err)
+	}
+	return nil
+}
+
+// loadEnv applies the environment variable of every flag that is set,
+// parsed by the flag itself
+func (c *Config) loadEnv(flags *flag.FlagSet) error {
+	if uri := os.Getenv("MONGOURI"); uri != "" {
+		c.MongoURI = uri
+	}
+	var err error
+	flags.VisitAll(func(f *flag.Flag) {
+		value, ok := os.LookupEnv(envName(f.Name))
+		if !ok || f.Name == "config" || err != nil {
+			return
+		}
+		if setErr := flags.Set(f.Name, value); setErr != nil {
+			err = fmt.Errorf("invalid value %q for %s: %v", value, envName(f.Name), setErr)
+		}
+	})
+	return err
+}
+
+// envName is the environment variable of a flag, i.e. WS_GATEWAY_ADDRESS
+// for -gateway-address
+func envName(flagName string) string {
+	return "WS_" + strings.ToUpper(strings.Replace(flagName, "-", "_", -1))
+}
+
+// flagSet defines a flag for every setting, defaulting to its current value
+func (c *Config) flagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.StringVar(&c.Address, "address", c.Address, "address of the gRPC server")
+	flags.StringVar(&c.GatewayAddress, "gateway-address", c.GatewayAddress, "address of the REST gateway and gRPC-Web")
+	flags.StringVar(&c.TLS.CertFile, "tls-cert", c.TLS.CertFile, "PEM certificate of the gRPC server, turns on TLS")
+	flags.StringVar(&c.TLS.KeyFile, "tls-key", c.TLS.KeyFile, "PEM private key of the certificate")
+	flags.StringVar(&c.TLS.ClientCAFile, "tls-client-ca", c.TLS.ClientCAFile, "PEM CA certificates clients must present a certificate of, turns on mutual TLS")
+	flags.DurationVar(&c.TLS.ReloadInterval, "tls-reload-interval", c.TLS.ReloadInterval, "how often the certificate files are checked for changes")
+	flags.StringVar(&c.Backend, "backend", c.Backend, "storage backend, one of "+strings.Join(Backends, ", "))
+	flags.StringVar(&c.MongoURI, "mongo-uri", c.MongoURI, "MongoDB connection string (MONGOURI)")
+	flags.StringVar(&c.Database, "database", c.Database, "name of the database")
+	for _, col := range c.Collections.collections() {
+		flags.StringVar(col.name, "collection-"+col.key, *col.name, "name of the "+col.key+" collection")
+	}
+	flags.IntVar(&c.MaxRecvMsgSize, "max-recv-msg-size", c.MaxRecvMsgSize, "largest request in bytes")
+	flags.IntVar(&c.MaxSendMsgSize, "max-send-msg-size", c.MaxSendMsgSize, "largest response in bytes")
+	flags.DurationVar(&c.ConnectTimeout, "connect-timeout", c.ConnectTimeout, "time to connect to the database")
+	flags.DurationVar(&c.RequestTimeout, "request-timeout", c.RequestTimeout, "deadline of every RPC, 0 for none")
+	flags.DurationVar(&c.HealthCheckInterval, "health-check-interval", c.HealthCheckInterval, "how often the database is pinged for the health service")
+	flags.DurationVar(&c.HealthCheckTimeout, "health-check-timeout", c.HealthCheckTimeout, "time a database ping may take")
+	flags.DurationVar(&c.ShutdownTimeout, "shutdown-timeout", c.ShutdownTimeout, "time calls in flight get to finish on shutdown before they are cut off")
+	flags.Var((*stringList)(&c.CORSOrigins), "cors-origins", "comma separated origins allowed to call from a browser, * for any")
+	flags.StringVar(&c.TokenSecret, "token-secret", c.TokenSecret, "secret signing the login tokens, better set with WS_TOKEN_SECRET")
+	flags.BoolVar(&c.Features.Gateway, "gateway", c.Features.Gateway, "serve the REST/JSON gateway")
+	flags.BoolVar(&c.Features.GRPCWeb, "grpc-web", c.Features.GRPCWeb, "serve gRPC-Web for browsers")
+	flags.BoolVar(&c.Features.Docs, "docs", c.Features.Docs, "serve the OpenAPI document and API explorer")
+	flags.BoolVar(&c.Features.Reflection, "reflection", c.Features.Reflection, "register gRPC server reflection")
+	return flags
+}
+
+// stringList is a comma separated list flag, replacing the list when set
+type stringList []string
+
+func (l *stringList) String() string {
+	if l == nil {
+		return ""
+	}
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = nil
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
+// checkAddress checks an address is a host and a port number
+func checkAddress(address string) error {
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 0 || n > 65535 {
+		return fmt.Errorf("port %q is not a number from 0 to 65535", port)
+	}
+	return nil
+}
+
+// Validate checks the settings make sense together, reporting every problem
+func (c *Config) Validate() error {
+	var problems []string
+	problem := func(format string, a ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, a...))
+	}
+
+	if err := checkAddress(c.Address); err != nil {
+		problem("address %q: %v", c.Address, err)
+	}
+	if c.Features.Gateway || c.Features.GRPCWeb {
+		if err := checkAddress(c.GatewayAddress); err != nil {
+			problem("gateway_address %q: %v", c.GatewayAddress, err)
+		} else if c.GatewayAddress == c.Address {
+			problem("gateway_address and address are both %q", c.Address)
+		}
+	}
+	if c.TLS.Enabled() || c.TLS.KeyFile != "" || c.TLS.ClientCAFile != "" {
+		if c.TLS.CertFile == "" || c.TLS.KeyFile == "" {
+			problem("tls needs both a cert_file and a key_file")
+		}
+		for _, file := range []string{c.TLS.CertFile, c.TLS.KeyFile, c.TLS.ClientCAFile} {
+			if file == "" {
+				continue
+			}
+			if _, err := os.Stat(file); err != nil {
+				problem("tls: %v", err)
+			}
+		}
+		if c.TLS.ReloadInterval <= 0 {
+			problem("tls.reload_interval must be positive, not %v", c.TLS.ReloadInterval)
+		}
+	}
+	if c.Features.Docs && !c.Features.Gateway {
+		problem("docs needs the gateway, which is turned off")
+	}
+
+	supported := false
+	for _, backend := range Backends {
+		supported = supported || c.Backend == backend
+	}
+	if !supported {
+		problem("backend %q is not supported, use one of %s", c.Backend, strings.Join(Backends, ", "))
+	}
+	if c.Database == "" {
+		problem("database is empty")
+	} else if strings.ContainsAny(c.Database, "/\\. \"$") || len(c.Database) > 63 {
+		problem("database %q is not a valid MongoDB database name", c.Database)
+	}
+	used := make(map[string]string)
+	for _, col := range c.Collections.collections() {
+		name := *col.name
+		switch {
+		case name == "":
+			problem("collections.%s is empty", col.key)
+		case strings.Contains(name, "$") || strings.HasPrefix(name, "system."):
+			problem("collections.%s %q is not a valid MongoDB collection name", col.key, name)
+		case used[name] != "":
+			problem("collections.%s and collections.%s are both %q", used[name], col.key, name)
+		default:
+			used[name] = col.key
+		}
+	}
+
+	if c.MaxRecvMsgSize <= 0 {
+		problem("max_recv_msg_size must be positive, not %d", c.MaxRecvMsgSize)
+	}
+	if c.MaxSendMsgSize <= 0 {
+		problem("max_send_msg_size must be positive, not %d", c.MaxSendMsgSize)
+	}
+	if c.ConnectTimeout <= 0 {
+		problem("connect_timeout must be positive, not %v", c.ConnectTimeout)
+	}
+	if c.RequestTimeout < 0 {
+		problem("request_timeout cannot be negative, use 0 for no deadline")
+	}
+	if c.HealthCheckInterval <= 0 {
+		problem("health_check_interval must be positive, not %v", c.HealthCheckInterval)
+	}
+	if c.HealthCheckTimeout <= 0 || c.HealthCheckTimeout > c.HealthCheckInterval {
+		problem("health_check_timeout must be positive and at most the health_check_interval, not %v", c.HealthCheckTimeout)
+	}
+
+	if c.ShutdownTimeout <= 0 {
+		problem("shutdown_timeout must be positive, not %v", c.ShutdownTimeout)
+	}
+
+	for _, origin := range c.CORSOrigins {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			problem("cors_origins %q is not an origin like https://example.com", origin)
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid configuration:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package wsconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// setenv sets environment variables for the rest of a test, clearing the
+// ones Load reads first so the environment of the machine does not leak in
+func setenv(t *testing.T, env map[string]string) {
+	t.Helper()
+	for _, pair := range os.Environ() {
+		name := strings.SplitN(pair, "=", 2)[0]
+		if strings.HasPrefix(name, "WS_") || name == "MONGOURI" {
+			value := os.Getenv(name)
+			os.Unsetenv(name)
+			t.Cleanup(func() { os.Setenv(name, value) })
+		}
+	}
+	for name, value := range env {
+		os.Setenv(name, value)
+		t.Cleanup(func() { os.Unsetenv(name) })
+	}
+}
+
+// tempDir is a directory removed when the test ends
+func tempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "wsconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+// writeConfig writes a config file, returning its path
+func writeConfig(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	dir := tempDir(t)
+	file := writeConfig(t, dir, "config.yaml", `
+address: 127.0.0.1:6000
+request_timeout: 5s
+mongo_uri: mongodb://file
+collections:
+  verse: file_verse
+  user: file_user
+cors_origins: [https://file.example]
+features:
+  reflection: false
+`)
+	other := writeConfig(t, dir, "other.yaml", "database: other\n")
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		args []string
+		want func(c *Config) // the changes from the defaults
+	}{
+		{
+			name: "defaults",
+			want: func(c *Config) {},
+		},
+		{
+			name: "the file over the defaults",
+			env:  map[string]string{"WS_CONFIG": file},
+			want: func(c *Config) {
+				c.Address, c.RequestTimeout, c.MongoURI = "127.0.0.1:6000", 5*time.Second, "mongodb://file"
+				c.Collections.Verse, c.Collections.User = "file_verse", "file_user"
+				c.CORSOrigins = []string{"https://file.example"}
+				c.Features.Reflection = false
+			},
+		},
+		{
+			name: "the environment over the file",
+			env: map[string]string{
+				"WS_CONFIG":           file,
+				"WS_REQUEST_TIMEOUT":  "7s",
+				"WS_COLLECTION_VERSE": "env_verse",
+				"WS_CORS_ORIGINS":     "https://a.example, https://b.example",
+				"WS_REFLECTION":       "true",
+				"MONGOURI":            "mongodb://mongouri",
+			},
+			want: func(c *Config) {
+				c.Address, c.RequestTimeout, c.MongoURI = "127.0.0.1:6000", 7*time.Second, "mongodb://mongouri"
+				c.Collections.Verse, c.Collections.User = "env_verse", "file_user"
+				c.CORSOrigins = []string{"https://a.example", "https://b.example"}
+			},
+		},
+		{
+			name: "WS_MONGO_URI over MONGOURI",
+			env:  map[string]string{"MONGOURI": "mongodb://mongouri", "WS_MONGO_URI": "mongodb://ws"},
+			want: func(c *Config) { c.MongoURI = "mongodb://ws" },
+		},
+		{
+			name: "the flags over the environment",
+			env:  map[string]string{"WS_CONFIG": file, "WS_REQUEST_TIMEOUT": "7s", "WS_COLLECTION_VERSE": "env_verse", "MONGOURI": "mongodb://mongouri"},
+			args: []string{"-request-timeout=9s", "-collection-verse", "flag_verse", "-mongo-uri=mongodb://flag", "-cors-origins=*"},
+			want: func(c *Config) {
+				c.Address, c.RequestTimeout, c.MongoURI = "127.0.0.1:6000", 9*time.Second, "mongodb://flag"
+				c.Collections.Verse, c.Collections.User = "flag_verse", "file_user"
+				c.CORSOrigins = []string{"*"}
+				c.Features.Reflection = false
+			},
+		},
+		{
+			name: "-config over WS_CONFIG",
+			env:  map[string]string{"WS_CONFIG": file},
+			args: []string{"-config", other},
+			want: func(c *Config) { c.Database = "other" },
+		},
+		{
+			name: "no gateway address without the gateway",
+			args: []string{"-gateway=false", "-grpc-web=false", "-docs=false", "-gateway-address=none"},
+			want: func(c *Config) {
+				c.Features.Gateway, c.Features.GRPCWeb, c.Features.Docs = false, false, false
+				c.GatewayAddress = "none"
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setenv(t, test.env)
+			got, err := Load("test", test.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := Default()
+			test.want(want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got  %+v\nwant %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestLoadRejects(t *testing.T) {
+	dir := tempDir(t)
+	cert := writeConfig(t, dir, "cert.pem", "certificate")
+	key := writeConfig(t, dir, "key.pem", "key")
+
+	tests := []struct {
+		name     string
+		file     string
+		env      map[string]string
+		args     []string
+		problems []string // in the error
+	}{
+		{name: "a duration flag without a unit", args: []string{"-request-timeout=30"}, problems: []string{"request-timeout"}},
+		{name: "a duration variable that is not one", env: map[string]string{"WS_HEALTH_CHECK_INTERVAL": "often"}, problems: []string{"WS_HEALTH_CHECK_INTERVAL"}},
+		{name: "a duration in the file that is not one", file: "connect_timeout: soon\n", problems: []string{"config file"}},
+		{name: "an unknown key in the file", file: "adress: 127.0.0.1:6000\n", problems: []string{"config file", "adress"}},
+		{name: "a negative request timeout", args: []string{"-request-timeout=-1s"}, problems: []string{"request_timeout cannot be negative"}},
+		{name: "no connect timeout", args: []string{"-connect-timeout=0"}, problems: []string{"connect_timeout must be positive"}},
+		{name: "no shutdown timeout", args: []string{"-shutdown-timeout=0s"}, problems: []string{"shutdown_timeout must be positive"}},
+		{name: "a health check longer than its interval", args: []string{"-health-check-timeout=1m"}, problems: []string{"health_check_timeout"}},
+		{name: "an address without a port", args: []string{"-address=localhost"}, problems: []string{`address "localhost"`}},
+		{name: "a port past 65535", args: []string{"-address=0.0.0.0:99999"}, problems: []string{`address "0.0.0.0:99999"`}},
+		{name: "a named port", args: []string{"-gateway-address=0.0.0.0:http"}, problems: []string{`gateway_address "0.0.0.0:http"`}},
+		{name: "a negative port", file: "address: \"[::1]:-1\"\n", problems: []string{`address "[::1]:-1"`}},
+		{name: "the same address twice", args: []string{"-gateway-address=0.0.0.0:50051"}, problems: []string{"gateway_address and address are both"}},
+		{name: "a certificate without a key", args: []string{"-tls-cert", cert}, problems: []string{"tls needs both a cert_file and a key_file"}},
+		{name: "a key without a certificate", args: []string{"-tls-key", key}, problems: []string{"tls needs both a cert_file and a key_file"}},
+		{name: "a client CA without a certificate", args: []string{"-tls-client-ca", cert}, problems: []string{"tls needs both a cert_file and a key_file"}},
+		{name: "missing certificate files", args: []string{"-tls-cert", filepath.Join(dir, "missing.pem"), "-tls-key", key}, problems: []string{"tls:", "missing.pem"}},
+		{name: "no reload interval", args: []string{"-tls-cert", cert, "-tls-key", key, "-tls-reload-interval=0s"}, problems: []string{"tls.reload_interval must be positive"}},
+		{name: "docs without the gateway", args: []string{"-gateway=false"}, problems: []string{"docs needs the gateway"}},
+		{name: "an unknown backend", env: map[string]string{"WS_BACKEND": "postgres"}, problems: []string{`backend "postgres"`}},
+		{name: "a collection used twice", args: []string{"-collection-user=verse"}, problems: []string{"collections.verse and collections.user"}},
+		{name: "an origin with a path", args: []string{"-cors-origins=https://example.com/app"}, problems: []string{"cors_origins"}},
+		{
+			name:     "every problem together",
+			args:     []string{"-address=localhost", "-connect-timeout=0", "-database="},
+			problems: []string{"address", "connect_timeout", "database is empty"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := test.env
+			if test.file != "" {
+				env = map[string]string{"WS_CONFIG": writeConfig(t, dir, "bad.yaml", test.file)}
+			}
+			setenv(t, env)
+			_, err := Load("test", test.args)
+			if err == nil {
+				t.Fatalf("loaded without an error")
+			}
+			for _, problem := range test.problems {
+				if !strings.Contains(err.Error(), problem) {
+					t.Errorf("the error %q does not mention %q", err, problem)
+				}
+			}
+		})
+	}
+}
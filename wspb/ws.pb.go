@@ -35,12 +35,17 @@ type Verse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Book     int32  `protobuf:"varint,1,opt,name=book,proto3" json:"book,omitempty"`
-	BookName string `protobuf:"bytes,2,opt,name=bookName,proto3" json:"bookName,omitempty"`
-	Chapter  int32  `protobuf:"varint,3,opt,name=chapter,proto3" json:"chapter,omitempty"`
-	Verse    int32  `protobuf:"varint,4,opt,name=verse,proto3" json:"verse,omitempty"`
-	Text     string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
-	Keywords string `protobuf:"bytes,6,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	Book        int32         `protobuf:"varint,1,opt,name=book,proto3" json:"book,omitempty"`
+	BookName    string        `protobuf:"bytes,2,opt,name=bookName,proto3" json:"bookName,omitempty"`
+	Chapter     int32         `protobuf:"varint,3,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	Verse       int32         `protobuf:"varint,4,opt,name=verse,proto3" json:"verse,omitempty"`
+	Text        string        `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	Keywords    string        `protobuf:"bytes,6,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	Words       []*Word       `protobuf:"bytes,7,rep,name=words,proto3" json:"words,omitempty"`              // per word Strong's tagging, when the translation has it
+	Spans       []*Span       `protobuf:"bytes,8,rep,name=spans,proto3" json:"spans,omitempty"`              // inline markup of the text
+	Headings    []string      `protobuf:"bytes,9,rep,name=headings,proto3" json:"headings,omitempty"`        // section (pericope) headings printed before this verse
+	Footnotes   []*Footnote   `protobuf:"bytes,10,rep,name=footnotes,proto3" json:"footnotes,omitempty"`     // when requested with include_footnotes
+	Annotations []*Annotation `protobuf:"bytes,11,rep,name=annotations,proto3" json:"annotations,omitempty"` // the caller's own, when requested with include_annotations
 }
 
 func (x *Verse) Reset() {
@@ -117,21 +122,245 @@ func (x *Verse) GetKeywords() string {
 	return ""
 }
 
+func (x *Verse) GetWords() []*Word {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *Verse) GetSpans() []*Span {
+	if x != nil {
+		return x.Spans
+	}
+	return nil
+}
+
+func (x *Verse) GetHeadings() []string {
+	if x != nil {
+		return x.Headings
+	}
+	return nil
+}
+
+func (x *Verse) GetFootnotes() []*Footnote {
+	if x != nil {
+		return x.Footnotes
+	}
+	return nil
+}
+
+func (x *Verse) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// Footnote or translator note of a verse, anchored at a Unicode code point offset into the verse text
+type Footnote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int32  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // note, alternate (alternate reading) or literal (literal rendering)
+	Text   string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *Footnote) Reset() {
+	*x = Footnote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Footnote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Footnote) ProtoMessage() {}
+
+func (x *Footnote) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Footnote.ProtoReflect.Descriptor instead.
+func (*Footnote) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Footnote) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Footnote) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Footnote) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// Span of marked up verse text: woc (words of Christ, red letter), added (supplied
+// words, italics in the KJV) or divine_name (LORD in small caps). Offsets count
+// Unicode code points of the verse text, end is exclusive.
+type Span struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Start int32  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int32  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *Span) Reset() {
+	*x = Span{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Span) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span) ProtoMessage() {}
+
+func (x *Span) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span.ProtoReflect.Descriptor instead.
+func (*Span) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Span) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Span) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Span) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// Word of a tagged verse and the Strong's numbers of the original language lemmas behind it
+type Word struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text    string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Strongs []string `protobuf:"bytes,2,rep,name=strongs,proto3" json:"strongs,omitempty"`
+}
+
+func (x *Word) Reset() {
+	*x = Word{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Word) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Word) ProtoMessage() {}
+
+func (x *Word) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Word.ProtoReflect.Descriptor instead.
+func (*Word) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Word) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Word) GetStrongs() []string {
+	if x != nil {
+		return x.Strongs
+	}
+	return nil
+}
+
 type VerseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Book       int32 `protobuf:"varint,1,opt,name=book,proto3" json:"book,omitempty"`
-	Chapter    int32 `protobuf:"varint,2,opt,name=chapter,proto3" json:"chapter,omitempty"`
-	VerseStart int32 `protobuf:"varint,3,opt,name=verse_start,json=verseStart,proto3" json:"verse_start,omitempty"`
-	VerseEnd   int32 `protobuf:"varint,4,opt,name=verse_end,json=verseEnd,proto3" json:"verse_end,omitempty"`
+	Book               int32  `protobuf:"varint,1,opt,name=book,proto3" json:"book,omitempty"`
+	Chapter            int32  `protobuf:"varint,2,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	VerseStart         int32  `protobuf:"varint,3,opt,name=verse_start,json=verseStart,proto3" json:"verse_start,omitempty"`
+	VerseEnd           int32  `protobuf:"varint,4,opt,name=verse_end,json=verseEnd,proto3" json:"verse_end,omitempty"`
+	Translation        string `protobuf:"bytes,5,opt,name=translation,proto3" json:"translation,omitempty"` // translation code, blank for the default text
+	IncludeFootnotes   bool   `protobuf:"varint,6,opt,name=include_footnotes,json=includeFootnotes,proto3" json:"include_footnotes,omitempty"`
+	IncludeAnnotations bool   `protobuf:"varint,7,opt,name=include_annotations,json=includeAnnotations,proto3" json:"include_annotations,omitempty"` // needs a signed in user
 }
 
 func (x *VerseRequest) Reset() {
 	*x = VerseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[1]
+		mi := &file_wspb_ws_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -144,7 +373,7 @@ func (x *VerseRequest) String() string {
 func (*VerseRequest) ProtoMessage() {}
 
 func (x *VerseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[1]
+	mi := &file_wspb_ws_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -157,7 +386,7 @@ func (x *VerseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerseRequest.ProtoReflect.Descriptor instead.
 func (*VerseRequest) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{1}
+	return file_wspb_ws_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *VerseRequest) GetBook() int32 {
@@ -188,6 +417,27 @@ func (x *VerseRequest) GetVerseEnd() int32 {
 	return 0
 }
 
+func (x *VerseRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *VerseRequest) GetIncludeFootnotes() bool {
+	if x != nil {
+		return x.IncludeFootnotes
+	}
+	return false
+}
+
+func (x *VerseRequest) GetIncludeAnnotations() bool {
+	if x != nil {
+		return x.IncludeAnnotations
+	}
+	return false
+}
+
 type VerseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -199,7 +449,7 @@ type VerseResponse struct {
 func (x *VerseResponse) Reset() {
 	*x = VerseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[2]
+		mi := &file_wspb_ws_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -212,7 +462,7 @@ func (x *VerseResponse) String() string {
 func (*VerseResponse) ProtoMessage() {}
 
 func (x *VerseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[2]
+	mi := &file_wspb_ws_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,7 +475,7 @@ func (x *VerseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerseResponse.ProtoReflect.Descriptor instead.
 func (*VerseResponse) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{2}
+	return file_wspb_ws_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *VerseResponse) GetVerses() []*Verse {
@@ -249,7 +499,7 @@ type BiblePlan struct {
 func (x *BiblePlan) Reset() {
 	*x = BiblePlan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[3]
+		mi := &file_wspb_ws_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -262,7 +512,7 @@ func (x *BiblePlan) String() string {
 func (*BiblePlan) ProtoMessage() {}
 
 func (x *BiblePlan) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[3]
+	mi := &file_wspb_ws_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,7 +525,7 @@ func (x *BiblePlan) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BiblePlan.ProtoReflect.Descriptor instead.
 func (*BiblePlan) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{3}
+	return file_wspb_ws_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BiblePlan) GetName() string {
@@ -310,7 +560,7 @@ type BiblePlanRequest struct {
 func (x *BiblePlanRequest) Reset() {
 	*x = BiblePlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[4]
+		mi := &file_wspb_ws_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -323,7 +573,7 @@ func (x *BiblePlanRequest) String() string {
 func (*BiblePlanRequest) ProtoMessage() {}
 
 func (x *BiblePlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[4]
+	mi := &file_wspb_ws_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +586,7 @@ func (x *BiblePlanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BiblePlanRequest.ProtoReflect.Descriptor instead.
 func (*BiblePlanRequest) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{4}
+	return file_wspb_ws_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BiblePlanRequest) GetName() string {
@@ -357,7 +607,7 @@ type BiblePlanResponse struct {
 func (x *BiblePlanResponse) Reset() {
 	*x = BiblePlanResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[5]
+		mi := &file_wspb_ws_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -370,7 +620,7 @@ func (x *BiblePlanResponse) String() string {
 func (*BiblePlanResponse) ProtoMessage() {}
 
 func (x *BiblePlanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[5]
+	mi := &file_wspb_ws_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,7 +633,7 @@ func (x *BiblePlanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BiblePlanResponse.ProtoReflect.Descriptor instead.
 func (*BiblePlanResponse) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{5}
+	return file_wspb_ws_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BiblePlanResponse) GetBiblePlan() []*BiblePlan {
@@ -398,17 +648,19 @@ type BiblePlanDay struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Reading1 string `protobuf:"bytes,2,opt,name=reading1,proto3" json:"reading1,omitempty"`
-	Reading2 string `protobuf:"bytes,3,opt,name=reading2,proto3" json:"reading2,omitempty"`
-	Reading3 string `protobuf:"bytes,4,opt,name=reading3,proto3" json:"reading3,omitempty"`
-	Reading4 string `protobuf:"bytes,5,opt,name=reading4,proto3" json:"reading4,omitempty"`
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Reading1 string   `protobuf:"bytes,2,opt,name=reading1,proto3" json:"reading1,omitempty"`
+	Reading2 string   `protobuf:"bytes,3,opt,name=reading2,proto3" json:"reading2,omitempty"`
+	Reading3 string   `protobuf:"bytes,4,opt,name=reading3,proto3" json:"reading3,omitempty"`
+	Reading4 string   `protobuf:"bytes,5,opt,name=reading4,proto3" json:"reading4,omitempty"`
+	Readings []string `protobuf:"bytes,6,rep,name=readings,proto3" json:"readings,omitempty"` // the reading of every track, for plans with more than four
+	Day      int32    `protobuf:"varint,7,opt,name=day,proto3" json:"day,omitempty"`
 }
 
 func (x *BiblePlanDay) Reset() {
 	*x = BiblePlanDay{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[6]
+		mi := &file_wspb_ws_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -421,7 +673,7 @@ func (x *BiblePlanDay) String() string {
 func (*BiblePlanDay) ProtoMessage() {}
 
 func (x *BiblePlanDay) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[6]
+	mi := &file_wspb_ws_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +686,7 @@ func (x *BiblePlanDay) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BiblePlanDay.ProtoReflect.Descriptor instead.
 func (*BiblePlanDay) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{6}
+	return file_wspb_ws_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BiblePlanDay) GetName() string {
@@ -472,19 +724,33 @@ func (x *BiblePlanDay) GetReading4() string {
 	return ""
 }
 
+func (x *BiblePlanDay) GetReadings() []string {
+	if x != nil {
+		return x.Readings
+	}
+	return nil
+}
+
+func (x *BiblePlanDay) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
 type BiblePlanDayRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Day  int32  `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	Day  int32  `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"` // 1 based day of the plan, 0 for today's day of the year
 }
 
 func (x *BiblePlanDayRequest) Reset() {
 	*x = BiblePlanDayRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[7]
+		mi := &file_wspb_ws_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -497,7 +763,7 @@ func (x *BiblePlanDayRequest) String() string {
 func (*BiblePlanDayRequest) ProtoMessage() {}
 
 func (x *BiblePlanDayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[7]
+	mi := &file_wspb_ws_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +776,7 @@ func (x *BiblePlanDayRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BiblePlanDayRequest.ProtoReflect.Descriptor instead.
 func (*BiblePlanDayRequest) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{7}
+	return file_wspb_ws_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BiblePlanDayRequest) GetName() string {
@@ -538,7 +804,7 @@ type BiblePlanDayResponse struct {
 func (x *BiblePlanDayResponse) Reset() {
 	*x = BiblePlanDayResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[8]
+		mi := &file_wspb_ws_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -551,7 +817,7 @@ func (x *BiblePlanDayResponse) String() string {
 func (*BiblePlanDayResponse) ProtoMessage() {}
 
 func (x *BiblePlanDayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[8]
+	mi := &file_wspb_ws_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +830,7 @@ func (x *BiblePlanDayResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BiblePlanDayResponse.ProtoReflect.Descriptor instead.
 func (*BiblePlanDayResponse) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{8}
+	return file_wspb_ws_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BiblePlanDayResponse) GetDay() *BiblePlanDay {
@@ -574,35 +840,35 @@ func (x *BiblePlanDayResponse) GetDay() *BiblePlanDay {
 	return nil
 }
 
-// Search
-type SearchRequest struct {
+// Bible Plan import and export (admin)
+type PlanError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Term     string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
-	Filter   string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`     // search filter, i.e. in, or exact
-	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"` // location of the search, default all, or nt (New Testament), ot, etc.
-	Options  string `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`   // future options to add more complex and specific searches
+	Day     int32  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Track   int32  `protobuf:"varint,2,opt,name=track,proto3" json:"track,omitempty"` // the reading column, BiblePlan number
+	Reading string `protobuf:"bytes,3,opt,name=reading,proto3" json:"reading,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SearchRequest) Reset() {
-	*x = SearchRequest{}
+func (x *PlanError) Reset() {
+	*x = PlanError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[9]
+		mi := &file_wspb_ws_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchRequest) String() string {
+func (x *PlanError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest) ProtoMessage() {}
+func (*PlanError) ProtoMessage() {}
 
-func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[9]
+func (x *PlanError) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,66 +879,67 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PlanError.ProtoReflect.Descriptor instead.
+func (*PlanError) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SearchRequest) GetTerm() string {
+func (x *PlanError) GetDay() int32 {
 	if x != nil {
-		return x.Term
+		return x.Day
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchRequest) GetFilter() string {
+func (x *PlanError) GetTrack() int32 {
 	if x != nil {
-		return x.Filter
+		return x.Track
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchRequest) GetLocation() string {
+func (x *PlanError) GetReading() string {
 	if x != nil {
-		return x.Location
+		return x.Reading
 	}
 	return ""
 }
 
-func (x *SearchRequest) GetOptions() string {
+func (x *PlanError) GetMessage() string {
 	if x != nil {
-		return x.Options
+		return x.Message
 	}
 	return ""
 }
 
-// Custom requests
-type BookRangeRequest struct {
+type ImportPlanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // beginning book number in the range of books
-	End   int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`     // ending book number, inclusive
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // plan name, required for csv and replacing the names of a json file
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // csv (a day column and one column per track) or json (a list of BiblePlan)
+	Data   []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	DryRun bool   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // validate only
 }
 
-func (x *BookRangeRequest) Reset() {
-	*x = BookRangeRequest{}
+func (x *ImportPlanRequest) Reset() {
+	*x = ImportPlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[10]
+		mi := &file_wspb_ws_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BookRangeRequest) String() string {
+func (x *ImportPlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookRangeRequest) ProtoMessage() {}
+func (*ImportPlanRequest) ProtoMessage() {}
 
-func (x *BookRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[10]
+func (x *ImportPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,52 +950,66 @@ func (x *BookRangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookRangeRequest.ProtoReflect.Descriptor instead.
-func (*BookRangeRequest) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ImportPlanRequest.ProtoReflect.Descriptor instead.
+func (*ImportPlanRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BookRangeRequest) GetStart() int32 {
+func (x *ImportPlanRequest) GetName() string {
 	if x != nil {
-		return x.Start
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *BookRangeRequest) GetEnd() int32 {
+func (x *ImportPlanRequest) GetFormat() string {
 	if x != nil {
-		return x.End
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-type ChapterRangeRequest struct {
+func (x *ImportPlanRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportPlanRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportPlanResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Book  int32 `protobuf:"varint,1,opt,name=book,proto3" json:"book,omitempty"`   // book number
-	Start int32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"` // start chapter in the given book
-	End   int32 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`     // end chapter
+	BiblePlan []*BiblePlan `protobuf:"bytes,1,rep,name=bible_plan,json=biblePlan,proto3" json:"bible_plan,omitempty"`
+	Errors    []*PlanError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"` // invalid readings, nothing is saved when there are any
+	Saved     bool         `protobuf:"varint,3,opt,name=saved,proto3" json:"saved,omitempty"`
 }
 
-func (x *ChapterRangeRequest) Reset() {
-	*x = ChapterRangeRequest{}
+func (x *ImportPlanResponse) Reset() {
+	*x = ImportPlanResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[11]
+		mi := &file_wspb_ws_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChapterRangeRequest) String() string {
+func (x *ImportPlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChapterRangeRequest) ProtoMessage() {}
+func (*ImportPlanResponse) ProtoMessage() {}
 
-func (x *ChapterRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[11]
+func (x *ImportPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,60 +1020,58 @@ func (x *ChapterRangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChapterRangeRequest.ProtoReflect.Descriptor instead.
-func (*ChapterRangeRequest) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ImportPlanResponse.ProtoReflect.Descriptor instead.
+func (*ImportPlanResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ChapterRangeRequest) GetBook() int32 {
+func (x *ImportPlanResponse) GetBiblePlan() []*BiblePlan {
 	if x != nil {
-		return x.Book
+		return x.BiblePlan
 	}
-	return 0
+	return nil
 }
 
-func (x *ChapterRangeRequest) GetStart() int32 {
+func (x *ImportPlanResponse) GetErrors() []*PlanError {
 	if x != nil {
-		return x.Start
+		return x.Errors
 	}
-	return 0
+	return nil
 }
 
-func (x *ChapterRangeRequest) GetEnd() int32 {
+func (x *ImportPlanResponse) GetSaved() bool {
 	if x != nil {
-		return x.End
+		return x.Saved
 	}
-	return 0
+	return false
 }
 
-type CustomRange struct {
+type ExportPlanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type        string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Booknumber  int32   `protobuf:"varint,3,opt,name=booknumber,proto3" json:"booknumber,omitempty"`
-	Customrange []int32 `protobuf:"varint,4,rep,packed,name=customrange,proto3" json:"customrange,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // csv or json
 }
 
-func (x *CustomRange) Reset() {
-	*x = CustomRange{}
+func (x *ExportPlanRequest) Reset() {
+	*x = ExportPlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[12]
+		mi := &file_wspb_ws_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CustomRange) String() string {
+func (x *ExportPlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomRange) ProtoMessage() {}
+func (*ExportPlanRequest) ProtoMessage() {}
 
-func (x *CustomRange) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[12]
+func (x *ExportPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,64 +1082,102 @@ func (x *CustomRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomRange.ProtoReflect.Descriptor instead.
-func (*CustomRange) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExportPlanRequest.ProtoReflect.Descriptor instead.
+func (*ExportPlanRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CustomRange) GetName() string {
+func (x *ExportPlanRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CustomRange) GetType() string {
+func (x *ExportPlanRequest) GetFormat() string {
 	if x != nil {
-		return x.Type
+		return x.Format
 	}
 	return ""
 }
 
-func (x *CustomRange) GetBooknumber() int32 {
-	if x != nil {
-		return x.Booknumber
+type ExportPlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportPlanResponse) Reset() {
+	*x = ExportPlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *CustomRange) GetCustomrange() []int32 {
+func (x *ExportPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPlanResponse) ProtoMessage() {}
+
+func (x *ExportPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPlanResponse.ProtoReflect.Descriptor instead.
+func (*ExportPlanResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportPlanResponse) GetData() []byte {
 	if x != nil {
-		return x.Customrange
+		return x.Data
 	}
 	return nil
 }
 
-type CustomRangeRequest struct {
+// Bible Plan generation, the scope is one of books, testament or custom_range
+// or several tracks each with their own scope
+type PlanTrackScope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Books       []int32 `protobuf:"varint,1,rep,packed,name=books,proto3" json:"books,omitempty"`
+	Testament   string  `protobuf:"bytes,2,opt,name=testament,proto3" json:"testament,omitempty"`
+	CustomRange string  `protobuf:"bytes,3,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"`
+	Cycles      int32   `protobuf:"varint,4,opt,name=cycles,proto3" json:"cycles,omitempty"` // times through the scope over the plan, default 1
 }
 
-func (x *CustomRangeRequest) Reset() {
-	*x = CustomRangeRequest{}
+func (x *PlanTrackScope) Reset() {
+	*x = PlanTrackScope{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[13]
+		mi := &file_wspb_ws_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CustomRangeRequest) String() string {
+func (x *PlanTrackScope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomRangeRequest) ProtoMessage() {}
+func (*PlanTrackScope) ProtoMessage() {}
 
-func (x *CustomRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[13]
+func (x *PlanTrackScope) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,43 +1188,73 @@ func (x *CustomRangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomRangeRequest.ProtoReflect.Descriptor instead.
-func (*CustomRangeRequest) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use PlanTrackScope.ProtoReflect.Descriptor instead.
+func (*PlanTrackScope) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CustomRangeRequest) GetName() string {
+func (x *PlanTrackScope) GetBooks() []int32 {
 	if x != nil {
-		return x.Name
+		return x.Books
+	}
+	return nil
+}
+
+func (x *PlanTrackScope) GetTestament() string {
+	if x != nil {
+		return x.Testament
 	}
 	return ""
 }
 
-type CustomRangeResponse struct {
+func (x *PlanTrackScope) GetCustomRange() string {
+	if x != nil {
+		return x.CustomRange
+	}
+	return ""
+}
+
+func (x *PlanTrackScope) GetCycles() int32 {
+	if x != nil {
+		return x.Cycles
+	}
+	return 0
+}
+
+type GeneratePlanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CustomRange *CustomRange `protobuf:"bytes,1,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"`
+	Books        []int32           `protobuf:"varint,1,rep,packed,name=books,proto3" json:"books,omitempty"`                        // book numbers, read in the order given
+	Testament    string            `protobuf:"bytes,2,opt,name=testament,proto3" json:"testament,omitempty"`                        // old or new
+	CustomRange  string            `protobuf:"bytes,3,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"` // name of a CustomRange
+	Days         int32             `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`
+	Strategy     string            `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`                              // balance days by verses (default), words or chapters
+	KeepChapters bool              `protobuf:"varint,6,opt,name=keep_chapters,json=keepChapters,proto3" json:"keep_chapters,omitempty"` // never split a chapter across days
+	Name         string            `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`                                      // plan name, required to save
+	Save         bool              `protobuf:"varint,8,opt,name=save,proto3" json:"save,omitempty"`                                     // save the plan, replacing a plan with the same name
+	Translation  string            `protobuf:"bytes,9,opt,name=translation,proto3" json:"translation,omitempty"`                        // translation to count verses and words in, blank for the default
+	Tracks       []*PlanTrackScope `protobuf:"bytes,10,rep,name=tracks,proto3" json:"tracks,omitempty"`                                 // concurrent tracks read side by side each day, instead of a single scope
 }
 
-func (x *CustomRangeResponse) Reset() {
-	*x = CustomRangeResponse{}
+func (x *GeneratePlanRequest) Reset() {
+	*x = GeneratePlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wspb_ws_proto_msgTypes[14]
+		mi := &file_wspb_ws_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CustomRangeResponse) String() string {
+func (x *GeneratePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomRangeResponse) ProtoMessage() {}
+func (*GeneratePlanRequest) ProtoMessage() {}
 
-func (x *CustomRangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wspb_ws_proto_msgTypes[14]
+func (x *GeneratePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,246 +1265,6572 @@ func (x *CustomRangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomRangeResponse.ProtoReflect.Descriptor instead.
-func (*CustomRangeResponse) Descriptor() ([]byte, []int) {
-	return file_wspb_ws_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GeneratePlanRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePlanRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CustomRangeResponse) GetCustomRange() *CustomRange {
+func (x *GeneratePlanRequest) GetBooks() []int32 {
 	if x != nil {
-		return x.CustomRange
+		return x.Books
 	}
 	return nil
 }
 
-var File_wspb_ws_proto protoreflect.FileDescriptor
+func (x *GeneratePlanRequest) GetTestament() string {
+	if x != nil {
+		return x.Testament
+	}
+	return ""
+}
 
-var file_wspb_ws_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x77, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0c, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x22, 0x97, 0x01,
-	0x0a, 0x05, 0x56, 0x65, 0x72, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x62,
-	0x6f, 0x6f, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62,
-	0x6f, 0x6f, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b,
-	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b,
-	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x7a, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f,
-	0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x45, 0x6e, 0x64, 0x22, 0x3c, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x73, 0x22, 0x4b, 0x0a, 0x09, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0x26,
-	0x0a, 0x10, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x11, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50,
-	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x62,
-	0x69, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42,
-	0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x09, 0x62, 0x69, 0x62, 0x6c, 0x65, 0x50,
-	0x6c, 0x61, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61,
-	0x6e, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x32,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x32,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x33, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x33, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x34, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x34, 0x22, 0x3b, 0x0a, 0x13, 0x42, 0x69, 0x62, 0x6c,
-	0x65, 0x50, 0x6c, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x44, 0x0a, 0x14, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c,
-	0x61, 0x6e, 0x44, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72,
-	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50,
-	0x6c, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x71, 0x0a, 0x0d, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d,
-	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3a,
-	0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x51, 0x0a, 0x13, 0x43, 0x68,
-	0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
-	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x77, 0x0a,
-	0x0b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x61,
-	0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x53, 0x0a, 0x13, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x32, 0xbc, 0x04, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a,
-	0x05, 0x56, 0x65, 0x72, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
-	0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x44, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x77, 0x6f,
-	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x42, 0x69, 0x62, 0x6c, 0x65,
-	0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x42, 0x69, 0x62, 0x6c, 0x65,
-	0x50, 0x6c, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x12, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e,
-	0x44, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x6f, 0x72,
-	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50,
-	0x6c, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4a, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x2e,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x6f,
-	0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0c,
-	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x77,
-	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56,
-	0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54,
-	0x0a, 0x0b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x2e,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x42, 0x22, 0x5a, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *GeneratePlanRequest) GetCustomRange() string {
+	if x != nil {
+		return x.CustomRange
+	}
+	return ""
 }
 
-var (
-	file_wspb_ws_proto_rawDescOnce sync.Once
-	file_wspb_ws_proto_rawDescData = file_wspb_ws_proto_rawDesc
-)
+func (x *GeneratePlanRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
 
-func file_wspb_ws_proto_rawDescGZIP() []byte {
-	file_wspb_ws_proto_rawDescOnce.Do(func() {
-		file_wspb_ws_proto_rawDescData = protoimpl.X.CompressGZIP(file_wspb_ws_proto_rawDescData)
-	})
-	return file_wspb_ws_proto_rawDescData
+func (x *GeneratePlanRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
 }
 
-var file_wspb_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_wspb_ws_proto_goTypes = []interface{}{
-	(*Verse)(nil),                // 0: wordsearcher.Verse
-	(*VerseRequest)(nil),         // 1: wordsearcher.VerseRequest
-	(*VerseResponse)(nil),        // 2: wordsearcher.VerseResponse
-	(*BiblePlan)(nil),            // 3: wordsearcher.BiblePlan
-	(*BiblePlanRequest)(nil),     // 4: wordsearcher.BiblePlanRequest
-	(*BiblePlanResponse)(nil),    // 5: wordsearcher.BiblePlanResponse
-	(*BiblePlanDay)(nil),         // 6: wordsearcher.BiblePlanDay
-	(*BiblePlanDayRequest)(nil),  // 7: wordsearcher.BiblePlanDayRequest
-	(*BiblePlanDayResponse)(nil), // 8: wordsearcher.BiblePlanDayResponse
-	(*SearchRequest)(nil),        // 9: wordsearcher.SearchRequest
-	(*BookRangeRequest)(nil),     // 10: wordsearcher.BookRangeRequest
-	(*ChapterRangeRequest)(nil),  // 11: wordsearcher.ChapterRangeRequest
-	(*CustomRange)(nil),          // 12: wordsearcher.CustomRange
-	(*CustomRangeRequest)(nil),   // 13: wordsearcher.CustomRangeRequest
-	(*CustomRangeResponse)(nil),  // 14: wordsearcher.CustomRangeResponse
+func (x *GeneratePlanRequest) GetKeepChapters() bool {
+	if x != nil {
+		return x.KeepChapters
+	}
+	return false
 }
-var file_wspb_ws_proto_depIdxs = []int32{
-	0,  // 0: wordsearcher.VerseResponse.verses:type_name -> wordsearcher.Verse
-	3,  // 1: wordsearcher.BiblePlanResponse.bible_plan:type_name -> wordsearcher.BiblePlan
-	6,  // 2: wordsearcher.BiblePlanDayResponse.day:type_name -> wordsearcher.BiblePlanDay
-	12, // 3: wordsearcher.CustomRangeResponse.custom_range:type_name -> wordsearcher.CustomRange
-	1,  // 4: wordsearcher.WordsearcherService.Verse:input_type -> wordsearcher.VerseRequest
-	9,  // 5: wordsearcher.WordsearcherService.Search:input_type -> wordsearcher.SearchRequest
-	4,  // 6: wordsearcher.WordsearcherService.BiblePlan:input_type -> wordsearcher.BiblePlanRequest
-	7,  // 7: wordsearcher.WordsearcherService.BiblePlanDay:input_type -> wordsearcher.BiblePlanDayRequest
-	10, // 8: wordsearcher.WordsearcherService.BookRange:input_type -> wordsearcher.BookRangeRequest
-	11, // 9: wordsearcher.WordsearcherService.ChapterRange:input_type -> wordsearcher.ChapterRangeRequest
-	13, // 10: wordsearcher.WordsearcherService.CustomRange:input_type -> wordsearcher.CustomRangeRequest
-	2,  // 11: wordsearcher.WordsearcherService.Verse:output_type -> wordsearcher.VerseResponse
-	2,  // 12: wordsearcher.WordsearcherService.Search:output_type -> wordsearcher.VerseResponse
-	5,  // 13: wordsearcher.WordsearcherService.BiblePlan:output_type -> wordsearcher.BiblePlanResponse
-	8,  // 14: wordsearcher.WordsearcherService.BiblePlanDay:output_type -> wordsearcher.BiblePlanDayResponse
-	2,  // 15: wordsearcher.WordsearcherService.BookRange:output_type -> wordsearcher.VerseResponse
-	2,  // 16: wordsearcher.WordsearcherService.ChapterRange:output_type -> wordsearcher.VerseResponse
-	14, // 17: wordsearcher.WordsearcherService.CustomRange:output_type -> wordsearcher.CustomRangeResponse
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+func (x *GeneratePlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func init() { file_wspb_ws_proto_init() }
-func file_wspb_ws_proto_init() {
-	if File_wspb_ws_proto != nil {
-		return
+func (x *GeneratePlanRequest) GetSave() bool {
+	if x != nil {
+		return x.Save
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_wspb_ws_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_wspb_ws_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerseRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_wspb_ws_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerseResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_wspb_ws_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BiblePlan); i {
+	return false
+}
+
+func (x *GeneratePlanRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *GeneratePlanRequest) GetTracks() []*PlanTrackScope {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+type GeneratePlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BiblePlan *BiblePlan   `protobuf:"bytes,1,opt,name=bible_plan,json=biblePlan,proto3" json:"bible_plan,omitempty"` // the first track
+	Tracks    []*BiblePlan `protobuf:"bytes,2,rep,name=tracks,proto3" json:"tracks,omitempty"`
+}
+
+func (x *GeneratePlanResponse) Reset() {
+	*x = GeneratePlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePlanResponse) ProtoMessage() {}
+
+func (x *GeneratePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePlanResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePlanResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GeneratePlanResponse) GetBiblePlan() *BiblePlan {
+	if x != nil {
+		return x.BiblePlan
+	}
+	return nil
+}
+
+func (x *GeneratePlanResponse) GetTracks() []*BiblePlan {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+// Search
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term          string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Filter        string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`                                       // search filter, i.e. in, exact, or strongs (term is a Strong's number like H2617)
+	Location      string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`                                   // location of the search, default all, or nt (New Testament), ot, etc.
+	Options       string `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`                                     // future options to add more complex and specific searches
+	Translation   string `protobuf:"bytes,5,opt,name=translation,proto3" json:"translation,omitempty"`                             // translation code to search, its analyzer decides if accents and points are folded
+	WordsOfChrist bool   `protobuf:"varint,6,opt,name=words_of_christ,json=wordsOfChrist,proto3" json:"words_of_christ,omitempty"` // only match within the words of Christ
+	Layer         string `protobuf:"bytes,7,opt,name=layer,proto3" json:"layer,omitempty"`                                         // text layer to search, text (default) or footnotes
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetWordsOfChrist() bool {
+	if x != nil {
+		return x.WordsOfChrist
+	}
+	return false
+}
+
+func (x *SearchRequest) GetLayer() string {
+	if x != nil {
+		return x.Layer
+	}
+	return ""
+}
+
+// Custom requests
+type BookRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start              int32  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // beginning book number in the range of books
+	End                int32  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`     // ending book number, inclusive
+	Translation        string `protobuf:"bytes,3,opt,name=translation,proto3" json:"translation,omitempty"`
+	IncludeFootnotes   bool   `protobuf:"varint,4,opt,name=include_footnotes,json=includeFootnotes,proto3" json:"include_footnotes,omitempty"`
+	IncludeAnnotations bool   `protobuf:"varint,5,opt,name=include_annotations,json=includeAnnotations,proto3" json:"include_annotations,omitempty"`
+}
+
+func (x *BookRangeRequest) Reset() {
+	*x = BookRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookRangeRequest) ProtoMessage() {}
+
+func (x *BookRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookRangeRequest.ProtoReflect.Descriptor instead.
+func (*BookRangeRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BookRangeRequest) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *BookRangeRequest) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *BookRangeRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *BookRangeRequest) GetIncludeFootnotes() bool {
+	if x != nil {
+		return x.IncludeFootnotes
+	}
+	return false
+}
+
+func (x *BookRangeRequest) GetIncludeAnnotations() bool {
+	if x != nil {
+		return x.IncludeAnnotations
+	}
+	return false
+}
+
+type ChapterRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book               int32  `protobuf:"varint,1,opt,name=book,proto3" json:"book,omitempty"`   // book number
+	Start              int32  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"` // start chapter in the given book
+	End                int32  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`     // end chapter
+	Translation        string `protobuf:"bytes,4,opt,name=translation,proto3" json:"translation,omitempty"`
+	IncludeFootnotes   bool   `protobuf:"varint,5,opt,name=include_footnotes,json=includeFootnotes,proto3" json:"include_footnotes,omitempty"`
+	IncludeAnnotations bool   `protobuf:"varint,6,opt,name=include_annotations,json=includeAnnotations,proto3" json:"include_annotations,omitempty"`
+}
+
+func (x *ChapterRangeRequest) Reset() {
+	*x = ChapterRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChapterRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChapterRangeRequest) ProtoMessage() {}
+
+func (x *ChapterRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChapterRangeRequest.ProtoReflect.Descriptor instead.
+func (*ChapterRangeRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ChapterRangeRequest) GetBook() int32 {
+	if x != nil {
+		return x.Book
+	}
+	return 0
+}
+
+func (x *ChapterRangeRequest) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ChapterRangeRequest) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *ChapterRangeRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *ChapterRangeRequest) GetIncludeFootnotes() bool {
+	if x != nil {
+		return x.IncludeFootnotes
+	}
+	return false
+}
+
+func (x *ChapterRangeRequest) GetIncludeAnnotations() bool {
+	if x != nil {
+		return x.IncludeAnnotations
+	}
+	return false
+}
+
+type CustomRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Booknumber  int32   `protobuf:"varint,3,opt,name=booknumber,proto3" json:"booknumber,omitempty"`
+	Customrange []int32 `protobuf:"varint,4,rep,packed,name=customrange,proto3" json:"customrange,omitempty"`
+}
+
+func (x *CustomRange) Reset() {
+	*x = CustomRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomRange) ProtoMessage() {}
+
+func (x *CustomRange) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomRange.ProtoReflect.Descriptor instead.
+func (*CustomRange) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CustomRange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomRange) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CustomRange) GetBooknumber() int32 {
+	if x != nil {
+		return x.Booknumber
+	}
+	return 0
+}
+
+func (x *CustomRange) GetCustomrange() []int32 {
+	if x != nil {
+		return x.Customrange
+	}
+	return nil
+}
+
+type CustomRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CustomRangeRequest) Reset() {
+	*x = CustomRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomRangeRequest) ProtoMessage() {}
+
+func (x *CustomRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomRangeRequest.ProtoReflect.Descriptor instead.
+func (*CustomRangeRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CustomRangeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CustomRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomRange *CustomRange `protobuf:"bytes,1,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"`
+}
+
+func (x *CustomRangeResponse) Reset() {
+	*x = CustomRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomRangeResponse) ProtoMessage() {}
+
+func (x *CustomRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomRangeResponse.ProtoReflect.Descriptor instead.
+func (*CustomRangeResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CustomRangeResponse) GetCustomRange() *CustomRange {
+	if x != nil {
+		return x.CustomRange
+	}
+	return nil
+}
+
+// Cross References
+type CrossReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromBook     int32    `protobuf:"varint,1,opt,name=from_book,json=fromBook,proto3" json:"from_book,omitempty"`
+	FromChapter  int32    `protobuf:"varint,2,opt,name=from_chapter,json=fromChapter,proto3" json:"from_chapter,omitempty"`
+	FromVerse    int32    `protobuf:"varint,3,opt,name=from_verse,json=fromVerse,proto3" json:"from_verse,omitempty"`
+	ToBook       int32    `protobuf:"varint,4,opt,name=to_book,json=toBook,proto3" json:"to_book,omitempty"`
+	ToChapter    int32    `protobuf:"varint,5,opt,name=to_chapter,json=toChapter,proto3" json:"to_chapter,omitempty"`
+	ToVerse      int32    `protobuf:"varint,6,opt,name=to_verse,json=toVerse,proto3" json:"to_verse,omitempty"`
+	ToEndBook    int32    `protobuf:"varint,7,opt,name=to_end_book,json=toEndBook,proto3" json:"to_end_book,omitempty"` // end of the referenced passage, equal to the start for a single verse
+	ToEndChapter int32    `protobuf:"varint,8,opt,name=to_end_chapter,json=toEndChapter,proto3" json:"to_end_chapter,omitempty"`
+	ToEndVerse   int32    `protobuf:"varint,9,opt,name=to_end_verse,json=toEndVerse,proto3" json:"to_end_verse,omitempty"`
+	Votes        int32    `protobuf:"varint,10,opt,name=votes,proto3" json:"votes,omitempty"`        // weight of the link, higher is more relevant
+	Reference    string   `protobuf:"bytes,11,opt,name=reference,proto3" json:"reference,omitempty"` // the referenced passage formatted for display, i.e. Proverbs 8:22-30
+	Verses       []*Verse `protobuf:"bytes,12,rep,name=verses,proto3" json:"verses,omitempty"`       // text of the referenced passage when include_text is set
+}
+
+func (x *CrossReference) Reset() {
+	*x = CrossReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossReference) ProtoMessage() {}
+
+func (x *CrossReference) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossReference.ProtoReflect.Descriptor instead.
+func (*CrossReference) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CrossReference) GetFromBook() int32 {
+	if x != nil {
+		return x.FromBook
+	}
+	return 0
+}
+
+func (x *CrossReference) GetFromChapter() int32 {
+	if x != nil {
+		return x.FromChapter
+	}
+	return 0
+}
+
+func (x *CrossReference) GetFromVerse() int32 {
+	if x != nil {
+		return x.FromVerse
+	}
+	return 0
+}
+
+func (x *CrossReference) GetToBook() int32 {
+	if x != nil {
+		return x.ToBook
+	}
+	return 0
+}
+
+func (x *CrossReference) GetToChapter() int32 {
+	if x != nil {
+		return x.ToChapter
+	}
+	return 0
+}
+
+func (x *CrossReference) GetToVerse() int32 {
+	if x != nil {
+		return x.ToVerse
+	}
+	return 0
+}
+
+func (x *CrossReference) GetToEndBook() int32 {
+	if x != nil {
+		return x.ToEndBook
+	}
+	return 0
+}
+
+func (x *CrossReference) GetToEndChapter() int32 {
+	if x != nil {
+		return x.ToEndChapter
+	}
+	return 0
+}
+
+func (x *CrossReference) GetToEndVerse() int32 {
+	if x != nil {
+		return x.ToEndVerse
+	}
+	return 0
+}
+
+func (x *CrossReference) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *CrossReference) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CrossReference) GetVerses() []*Verse {
+	if x != nil {
+		return x.Verses
+	}
+	return nil
+}
+
+type CrossReferencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book        int32 `protobuf:"varint,1,opt,name=book,proto3" json:"book,omitempty"`
+	Chapter     int32 `protobuf:"varint,2,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	VerseStart  int32 `protobuf:"varint,3,opt,name=verse_start,json=verseStart,proto3" json:"verse_start,omitempty"`
+	VerseEnd    int32 `protobuf:"varint,4,opt,name=verse_end,json=verseEnd,proto3" json:"verse_end,omitempty"`          // 0 for a single verse
+	IncludeText bool  `protobuf:"varint,5,opt,name=include_text,json=includeText,proto3" json:"include_text,omitempty"` // include the text of the referenced verses
+	MinVotes    int32 `protobuf:"varint,6,opt,name=min_votes,json=minVotes,proto3" json:"min_votes,omitempty"`          // leave out links with fewer votes
+	Limit       int32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                // maximum links returned, 0 for all
+}
+
+func (x *CrossReferencesRequest) Reset() {
+	*x = CrossReferencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossReferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossReferencesRequest) ProtoMessage() {}
+
+func (x *CrossReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossReferencesRequest.ProtoReflect.Descriptor instead.
+func (*CrossReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CrossReferencesRequest) GetBook() int32 {
+	if x != nil {
+		return x.Book
+	}
+	return 0
+}
+
+func (x *CrossReferencesRequest) GetChapter() int32 {
+	if x != nil {
+		return x.Chapter
+	}
+	return 0
+}
+
+func (x *CrossReferencesRequest) GetVerseStart() int32 {
+	if x != nil {
+		return x.VerseStart
+	}
+	return 0
+}
+
+func (x *CrossReferencesRequest) GetVerseEnd() int32 {
+	if x != nil {
+		return x.VerseEnd
+	}
+	return 0
+}
+
+func (x *CrossReferencesRequest) GetIncludeText() bool {
+	if x != nil {
+		return x.IncludeText
+	}
+	return false
+}
+
+func (x *CrossReferencesRequest) GetMinVotes() int32 {
+	if x != nil {
+		return x.MinVotes
+	}
+	return 0
+}
+
+func (x *CrossReferencesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CrossReferencesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CrossReferences []*CrossReference `protobuf:"bytes,1,rep,name=cross_references,json=crossReferences,proto3" json:"cross_references,omitempty"`
+}
+
+func (x *CrossReferencesResponse) Reset() {
+	*x = CrossReferencesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossReferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossReferencesResponse) ProtoMessage() {}
+
+func (x *CrossReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossReferencesResponse.ProtoReflect.Descriptor instead.
+func (*CrossReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CrossReferencesResponse) GetCrossReferences() []*CrossReference {
+	if x != nil {
+		return x.CrossReferences
+	}
+	return nil
+}
+
+// Lexicon
+type LexiconEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strongs         string `protobuf:"bytes,1,opt,name=strongs,proto3" json:"strongs,omitempty"` // normalized Strong's number, i.e. H2617
+	Lemma           string `protobuf:"bytes,2,opt,name=lemma,proto3" json:"lemma,omitempty"`     // original language lemma
+	Transliteration string `protobuf:"bytes,3,opt,name=transliteration,proto3" json:"transliteration,omitempty"`
+	Pronunciation   string `protobuf:"bytes,4,opt,name=pronunciation,proto3" json:"pronunciation,omitempty"`
+	Definition      string `protobuf:"bytes,5,opt,name=definition,proto3" json:"definition,omitempty"`             // Strong's definition
+	KjvUsage        string `protobuf:"bytes,6,opt,name=kjv_usage,json=kjvUsage,proto3" json:"kjv_usage,omitempty"` // how the KJV translates the lemma
+	Derivation      string `protobuf:"bytes,7,opt,name=derivation,proto3" json:"derivation,omitempty"`
+	Occurrences     int32  `protobuf:"varint,8,opt,name=occurrences,proto3" json:"occurrences,omitempty"` // number of tagged words carrying this number
+}
+
+func (x *LexiconEntry) Reset() {
+	*x = LexiconEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LexiconEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconEntry) ProtoMessage() {}
+
+func (x *LexiconEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconEntry.ProtoReflect.Descriptor instead.
+func (*LexiconEntry) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LexiconEntry) GetStrongs() string {
+	if x != nil {
+		return x.Strongs
+	}
+	return ""
+}
+
+func (x *LexiconEntry) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
+func (x *LexiconEntry) GetTransliteration() string {
+	if x != nil {
+		return x.Transliteration
+	}
+	return ""
+}
+
+func (x *LexiconEntry) GetPronunciation() string {
+	if x != nil {
+		return x.Pronunciation
+	}
+	return ""
+}
+
+func (x *LexiconEntry) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *LexiconEntry) GetKjvUsage() string {
+	if x != nil {
+		return x.KjvUsage
+	}
+	return ""
+}
+
+func (x *LexiconEntry) GetDerivation() string {
+	if x != nil {
+		return x.Derivation
+	}
+	return ""
+}
+
+func (x *LexiconEntry) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+type LexiconRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strongs string `protobuf:"bytes,1,opt,name=strongs,proto3" json:"strongs,omitempty"`
+}
+
+func (x *LexiconRequest) Reset() {
+	*x = LexiconRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LexiconRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconRequest) ProtoMessage() {}
+
+func (x *LexiconRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconRequest.ProtoReflect.Descriptor instead.
+func (*LexiconRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LexiconRequest) GetStrongs() string {
+	if x != nil {
+		return x.Strongs
+	}
+	return ""
+}
+
+type LexiconResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *LexiconEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *LexiconResponse) Reset() {
+	*x = LexiconResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LexiconResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconResponse) ProtoMessage() {}
+
+func (x *LexiconResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconResponse.ProtoReflect.Descriptor instead.
+func (*LexiconResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LexiconResponse) GetEntry() *LexiconEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// Interlinear
+type InterlinearToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int32   `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"` // word position in the source verse, from 1
+	Text     string  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`          // source language word as printed
+	Lemma    string  `protobuf:"bytes,3,opt,name=lemma,proto3" json:"lemma,omitempty"`
+	Strongs  string  `protobuf:"bytes,4,opt,name=strongs,proto3" json:"strongs,omitempty"`
+	Morph    string  `protobuf:"bytes,5,opt,name=morph,proto3" json:"morph,omitempty"` // morphology code of the dataset, i.e. HR/Ncfsa (OSHB) or N- ----NSF- (MorphGNT)
+	Gloss    string  `protobuf:"bytes,6,opt,name=gloss,proto3" json:"gloss,omitempty"`
+	English  []int32 `protobuf:"varint,7,rep,packed,name=english,proto3" json:"english,omitempty"` // indexes into the words of the English verse aligned to this token
+}
+
+func (x *InterlinearToken) Reset() {
+	*x = InterlinearToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterlinearToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterlinearToken) ProtoMessage() {}
+
+func (x *InterlinearToken) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterlinearToken.ProtoReflect.Descriptor instead.
+func (*InterlinearToken) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InterlinearToken) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *InterlinearToken) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *InterlinearToken) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
+func (x *InterlinearToken) GetStrongs() string {
+	if x != nil {
+		return x.Strongs
+	}
+	return ""
+}
+
+func (x *InterlinearToken) GetMorph() string {
+	if x != nil {
+		return x.Morph
+	}
+	return ""
+}
+
+func (x *InterlinearToken) GetGloss() string {
+	if x != nil {
+		return x.Gloss
+	}
+	return ""
+}
+
+func (x *InterlinearToken) GetEnglish() []int32 {
+	if x != nil {
+		return x.English
+	}
+	return nil
+}
+
+type InterlinearVerse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book     int32               `protobuf:"varint,1,opt,name=book,proto3" json:"book,omitempty"`
+	Chapter  int32               `protobuf:"varint,2,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	Verse    int32               `protobuf:"varint,3,opt,name=verse,proto3" json:"verse,omitempty"`
+	Source   string              `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`     // source text, i.e. SBLGNT or OSHB
+	Language string              `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"` // grc (Greek) or hbo (Hebrew)
+	Tokens   []*InterlinearToken `protobuf:"bytes,6,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	English  *Verse              `protobuf:"bytes,7,opt,name=english,proto3" json:"english,omitempty"` // the English verse the tokens are aligned to
+}
+
+func (x *InterlinearVerse) Reset() {
+	*x = InterlinearVerse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterlinearVerse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterlinearVerse) ProtoMessage() {}
+
+func (x *InterlinearVerse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterlinearVerse.ProtoReflect.Descriptor instead.
+func (*InterlinearVerse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InterlinearVerse) GetBook() int32 {
+	if x != nil {
+		return x.Book
+	}
+	return 0
+}
+
+func (x *InterlinearVerse) GetChapter() int32 {
+	if x != nil {
+		return x.Chapter
+	}
+	return 0
+}
+
+func (x *InterlinearVerse) GetVerse() int32 {
+	if x != nil {
+		return x.Verse
+	}
+	return 0
+}
+
+func (x *InterlinearVerse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *InterlinearVerse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *InterlinearVerse) GetTokens() []*InterlinearToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *InterlinearVerse) GetEnglish() *Verse {
+	if x != nil {
+		return x.English
+	}
+	return nil
+}
+
+type InterlinearRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book       int32 `protobuf:"varint,1,opt,name=book,proto3" json:"book,omitempty"`
+	Chapter    int32 `protobuf:"varint,2,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	VerseStart int32 `protobuf:"varint,3,opt,name=verse_start,json=verseStart,proto3" json:"verse_start,omitempty"` // 0 for the whole chapter
+	VerseEnd   int32 `protobuf:"varint,4,opt,name=verse_end,json=verseEnd,proto3" json:"verse_end,omitempty"`
+}
+
+func (x *InterlinearRequest) Reset() {
+	*x = InterlinearRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterlinearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterlinearRequest) ProtoMessage() {}
+
+func (x *InterlinearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterlinearRequest.ProtoReflect.Descriptor instead.
+func (*InterlinearRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InterlinearRequest) GetBook() int32 {
+	if x != nil {
+		return x.Book
+	}
+	return 0
+}
+
+func (x *InterlinearRequest) GetChapter() int32 {
+	if x != nil {
+		return x.Chapter
+	}
+	return 0
+}
+
+func (x *InterlinearRequest) GetVerseStart() int32 {
+	if x != nil {
+		return x.VerseStart
+	}
+	return 0
+}
+
+func (x *InterlinearRequest) GetVerseEnd() int32 {
+	if x != nil {
+		return x.VerseEnd
+	}
+	return 0
+}
+
+type InterlinearResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verses []*InterlinearVerse `protobuf:"bytes,1,rep,name=verses,proto3" json:"verses,omitempty"`
+}
+
+func (x *InterlinearResponse) Reset() {
+	*x = InterlinearResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterlinearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterlinearResponse) ProtoMessage() {}
+
+func (x *InterlinearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterlinearResponse.ProtoReflect.Descriptor instead.
+func (*InterlinearResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InterlinearResponse) GetVerses() []*InterlinearVerse {
+	if x != nil {
+		return x.Verses
+	}
+	return nil
+}
+
+// Pericopes
+type Pericope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title        string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // i.e. The Sermon on the Mount
+	Book         int32  `protobuf:"varint,2,opt,name=book,proto3" json:"book,omitempty"`
+	ChapterStart int32  `protobuf:"varint,3,opt,name=chapter_start,json=chapterStart,proto3" json:"chapter_start,omitempty"`
+	VerseStart   int32  `protobuf:"varint,4,opt,name=verse_start,json=verseStart,proto3" json:"verse_start,omitempty"`
+	ChapterEnd   int32  `protobuf:"varint,5,opt,name=chapter_end,json=chapterEnd,proto3" json:"chapter_end,omitempty"` // the section runs until the next one starts
+	VerseEnd     int32  `protobuf:"varint,6,opt,name=verse_end,json=verseEnd,proto3" json:"verse_end,omitempty"`
+	Reference    string `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"` // formatted for display, i.e. Matthew 5:1-7:29
+}
+
+func (x *Pericope) Reset() {
+	*x = Pericope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pericope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pericope) ProtoMessage() {}
+
+func (x *Pericope) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pericope.ProtoReflect.Descriptor instead.
+func (*Pericope) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Pericope) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Pericope) GetBook() int32 {
+	if x != nil {
+		return x.Book
+	}
+	return 0
+}
+
+func (x *Pericope) GetChapterStart() int32 {
+	if x != nil {
+		return x.ChapterStart
+	}
+	return 0
+}
+
+func (x *Pericope) GetVerseStart() int32 {
+	if x != nil {
+		return x.VerseStart
+	}
+	return 0
+}
+
+func (x *Pericope) GetChapterEnd() int32 {
+	if x != nil {
+		return x.ChapterEnd
+	}
+	return 0
+}
+
+func (x *Pericope) GetVerseEnd() int32 {
+	if x != nil {
+		return x.VerseEnd
+	}
+	return 0
+}
+
+func (x *Pericope) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type PericopesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book        int32  `protobuf:"varint,1,opt,name=book,proto3" json:"book,omitempty"`
+	Translation string `protobuf:"bytes,2,opt,name=translation,proto3" json:"translation,omitempty"`
+}
+
+func (x *PericopesRequest) Reset() {
+	*x = PericopesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PericopesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PericopesRequest) ProtoMessage() {}
+
+func (x *PericopesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PericopesRequest.ProtoReflect.Descriptor instead.
+func (*PericopesRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PericopesRequest) GetBook() int32 {
+	if x != nil {
+		return x.Book
+	}
+	return 0
+}
+
+func (x *PericopesRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+type PericopesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pericopes []*Pericope `protobuf:"bytes,1,rep,name=pericopes,proto3" json:"pericopes,omitempty"`
+}
+
+func (x *PericopesResponse) Reset() {
+	*x = PericopesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PericopesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PericopesResponse) ProtoMessage() {}
+
+func (x *PericopesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PericopesResponse.ProtoReflect.Descriptor instead.
+func (*PericopesResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PericopesResponse) GetPericopes() []*Pericope {
+	if x != nil {
+		return x.Pericopes
+	}
+	return nil
+}
+
+// Users, calls to personal RPCs carry the token in the "authorization"
+// metadata as "Bearer <token>"
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email    string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Admin    bool   `protobuf:"varint,4,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RegisterRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AuthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Expires int64  `protobuf:"varint,2,opt,name=expires,proto3" json:"expires,omitempty"` // unix seconds
+	User    *User  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *AuthResponse) Reset() {
+	*x = AuthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthResponse) ProtoMessage() {}
+
+func (x *AuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AuthResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *AuthResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type MeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MeRequest) Reset() {
+	*x = MeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeRequest) ProtoMessage() {}
+
+func (x *MeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeRequest.ProtoReflect.Descriptor instead.
+func (*MeRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{43}
+}
+
+type UserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserResponse) ProtoMessage() {}
+
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Reading plan enrollment and progress of the signed in user
+type Enrollment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan      string `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD, day 1 of the plan
+	Timezone  string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                    // IANA time zone the days change in, i.e. America/Chicago
+	Days      int32  `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`                           // length of the plan
+}
+
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Enrollment) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *Enrollment) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Enrollment) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Enrollment) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type EnrollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan      string `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // defaults to today
+	Timezone  string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                    // defaults to UTC
+	Restart   bool   `protobuf:"varint,4,opt,name=restart,proto3" json:"restart,omitempty"`                     // start over when already enrolled, clearing the progress
+}
+
+func (x *EnrollRequest) Reset() {
+	*x = EnrollRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollRequest) ProtoMessage() {}
+
+func (x *EnrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollRequest.ProtoReflect.Descriptor instead.
+func (*EnrollRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EnrollRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *EnrollRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *EnrollRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *EnrollRequest) GetRestart() bool {
+	if x != nil {
+		return x.Restart
+	}
+	return false
+}
+
+type EnrollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enrollment *Enrollment `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+}
+
+func (x *EnrollResponse) Reset() {
+	*x = EnrollResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollResponse) ProtoMessage() {}
+
+func (x *EnrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollResponse.ProtoReflect.Descriptor instead.
+func (*EnrollResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EnrollResponse) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+type MarkCompleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan  string `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	Day   int32  `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`     // plan day, 0 for today's
+	Track int32  `protobuf:"varint,3,opt,name=track,proto3" json:"track,omitempty"` // reading of the day, 0 for all of them
+	Undo  bool   `protobuf:"varint,4,opt,name=undo,proto3" json:"undo,omitempty"`   // mark incomplete instead
+}
+
+func (x *MarkCompleteRequest) Reset() {
+	*x = MarkCompleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkCompleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCompleteRequest) ProtoMessage() {}
+
+func (x *MarkCompleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCompleteRequest.ProtoReflect.Descriptor instead.
+func (*MarkCompleteRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MarkCompleteRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *MarkCompleteRequest) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *MarkCompleteRequest) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *MarkCompleteRequest) GetUndo() bool {
+	if x != nil {
+		return x.Undo
+	}
+	return false
+}
+
+type PlanProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan string `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+}
+
+func (x *PlanProgressRequest) Reset() {
+	*x = PlanProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanProgressRequest) ProtoMessage() {}
+
+func (x *PlanProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanProgressRequest.ProtoReflect.Descriptor instead.
+func (*PlanProgressRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PlanProgressRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+type PlanProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan          string  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	CurrentDay    int32   `protobuf:"varint,2,opt,name=current_day,json=currentDay,proto3" json:"current_day,omitempty"`
+	Days          int32   `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`
+	CompletedDays int32   `protobuf:"varint,4,opt,name=completed_days,json=completedDays,proto3" json:"completed_days,omitempty"`
+	Percent       float64 `protobuf:"fixed64,5,opt,name=percent,proto3" json:"percent,omitempty"`                               // of the readings of the plan
+	Streak        int32   `protobuf:"varint,6,opt,name=streak,proto3" json:"streak,omitempty"`                                  // complete days in a row up to today
+	MissedDays    []int32 `protobuf:"varint,7,rep,packed,name=missed_days,json=missedDays,proto3" json:"missed_days,omitempty"` // past days that are not complete
+	Finished      bool    `protobuf:"varint,8,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (x *PlanProgress) Reset() {
+	*x = PlanProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanProgress) ProtoMessage() {}
+
+func (x *PlanProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanProgress.ProtoReflect.Descriptor instead.
+func (*PlanProgress) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PlanProgress) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *PlanProgress) GetCurrentDay() int32 {
+	if x != nil {
+		return x.CurrentDay
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetCompletedDays() int32 {
+	if x != nil {
+		return x.CompletedDays
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetMissedDays() []int32 {
+	if x != nil {
+		return x.MissedDays
+	}
+	return nil
+}
+
+func (x *PlanProgress) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+type PlanProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Progress *PlanProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (x *PlanProgressResponse) Reset() {
+	*x = PlanProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanProgressResponse) ProtoMessage() {}
+
+func (x *PlanProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanProgressResponse.ProtoReflect.Descriptor instead.
+func (*PlanProgressResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PlanProgressResponse) GetProgress() *PlanProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type CatchUpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan string `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	Days int32  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // days to spread the missed readings over, 1 to 90
+}
+
+func (x *CatchUpRequest) Reset() {
+	*x = CatchUpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatchUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatchUpRequest) ProtoMessage() {}
+
+func (x *CatchUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatchUpRequest.ProtoReflect.Descriptor instead.
+func (*CatchUpRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CatchUpRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *CatchUpRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type PlanReading struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day     int32  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"` // plan day the reading belongs to
+	Track   int32  `protobuf:"varint,2,opt,name=track,proto3" json:"track,omitempty"`
+	Reading string `protobuf:"bytes,3,opt,name=reading,proto3" json:"reading,omitempty"`
+}
+
+func (x *PlanReading) Reset() {
+	*x = PlanReading{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanReading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanReading) ProtoMessage() {}
+
+func (x *PlanReading) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanReading.ProtoReflect.Descriptor instead.
+func (*PlanReading) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PlanReading) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *PlanReading) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *PlanReading) GetReading() string {
+	if x != nil {
+		return x.Reading
+	}
+	return ""
+}
+
+type CatchUpDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date     string         `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`         // YYYY-MM-DD
+	Day      int32          `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`          // plan day
+	Readings []*PlanReading `protobuf:"bytes,3,rep,name=readings,proto3" json:"readings,omitempty"` // the day's own readings then the missed ones
+}
+
+func (x *CatchUpDay) Reset() {
+	*x = CatchUpDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatchUpDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatchUpDay) ProtoMessage() {}
+
+func (x *CatchUpDay) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatchUpDay.ProtoReflect.Descriptor instead.
+func (*CatchUpDay) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CatchUpDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CatchUpDay) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *CatchUpDay) GetReadings() []*PlanReading {
+	if x != nil {
+		return x.Readings
+	}
+	return nil
+}
+
+type CatchUpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days []*CatchUpDay `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+}
+
+func (x *CatchUpResponse) Reset() {
+	*x = CatchUpResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatchUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatchUpResponse) ProtoMessage() {}
+
+func (x *CatchUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatchUpResponse.ProtoReflect.Descriptor instead.
+func (*CatchUpResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CatchUpResponse) GetDays() []*CatchUpDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// Bookmarks, highlights and notes of the signed in user on a verse or a
+// range of verses in one book
+type Annotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // bookmark, highlight or note
+	Book       int32    `protobuf:"varint,3,opt,name=book,proto3" json:"book,omitempty"`
+	Chapter    int32    `protobuf:"varint,4,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	Verse      int32    `protobuf:"varint,5,opt,name=verse,proto3" json:"verse,omitempty"`
+	EndChapter int32    `protobuf:"varint,6,opt,name=end_chapter,json=endChapter,proto3" json:"end_chapter,omitempty"` // defaults to chapter
+	EndVerse   int32    `protobuf:"varint,7,opt,name=end_verse,json=endVerse,proto3" json:"end_verse,omitempty"`       // defaults to verse
+	Color      string   `protobuf:"bytes,8,opt,name=color,proto3" json:"color,omitempty"`                              // highlight color, a name (yellow, green...) or #rrggbb
+	Note       string   `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`                                // Markdown text of a note
+	Tags       []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	Created    int64    `protobuf:"varint,11,opt,name=created,proto3" json:"created,omitempty"` // unix seconds
+	Updated    int64    `protobuf:"varint,12,opt,name=updated,proto3" json:"updated,omitempty"`
+	Reference  string   `protobuf:"bytes,13,opt,name=reference,proto3" json:"reference,omitempty"` // display form of the range, i.e. John 3:16-18
+}
+
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotation) ProtoMessage() {}
+
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Annotation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Annotation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Annotation) GetBook() int32 {
+	if x != nil {
+		return x.Book
+	}
+	return 0
+}
+
+func (x *Annotation) GetChapter() int32 {
+	if x != nil {
+		return x.Chapter
+	}
+	return 0
+}
+
+func (x *Annotation) GetVerse() int32 {
+	if x != nil {
+		return x.Verse
+	}
+	return 0
+}
+
+func (x *Annotation) GetEndChapter() int32 {
+	if x != nil {
+		return x.EndChapter
+	}
+	return 0
+}
+
+func (x *Annotation) GetEndVerse() int32 {
+	if x != nil {
+		return x.EndVerse
+	}
+	return 0
+}
+
+func (x *Annotation) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Annotation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Annotation) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Annotation) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *Annotation) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *Annotation) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type AnnotationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Annotation *Annotation `protobuf:"bytes,1,opt,name=annotation,proto3" json:"annotation,omitempty"`
+}
+
+func (x *AnnotationRequest) Reset() {
+	*x = AnnotationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotationRequest) ProtoMessage() {}
+
+func (x *AnnotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotationRequest.ProtoReflect.Descriptor instead.
+func (*AnnotationRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AnnotationRequest) GetAnnotation() *Annotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+type AnnotationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Annotation *Annotation `protobuf:"bytes,1,opt,name=annotation,proto3" json:"annotation,omitempty"`
+}
+
+func (x *AnnotationResponse) Reset() {
+	*x = AnnotationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotationResponse) ProtoMessage() {}
+
+func (x *AnnotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotationResponse.ProtoReflect.Descriptor instead.
+func (*AnnotationResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AnnotationResponse) GetAnnotation() *Annotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+type DeleteAnnotationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteAnnotationRequest) Reset() {
+	*x = DeleteAnnotationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAnnotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnotationRequest) ProtoMessage() {}
+
+func (x *DeleteAnnotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnotationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAnnotationRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteAnnotationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAnnotationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteAnnotationResponse) Reset() {
+	*x = DeleteAnnotationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAnnotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnotationResponse) ProtoMessage() {}
+
+func (x *DeleteAnnotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnotationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAnnotationResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{60}
+}
+
+type ListAnnotationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // filters, blank or 0 for any
+	Book  int32  `protobuf:"varint,2,opt,name=book,proto3" json:"book,omitempty"`
+	Tag   string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Since int64  `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"` // updated at or after, unix seconds
+	Until int64  `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"` // updated before, unix seconds
+	Limit int32  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"` // 0 for 100, at most 500
+}
+
+func (x *ListAnnotationsRequest) Reset() {
+	*x = ListAnnotationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnnotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnotationsRequest) ProtoMessage() {}
+
+func (x *ListAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListAnnotationsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListAnnotationsRequest) GetBook() int32 {
+	if x != nil {
+		return x.Book
+	}
+	return 0
+}
+
+func (x *ListAnnotationsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListAnnotationsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListAnnotationsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListAnnotationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAnnotationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Annotations []*Annotation `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty"` // most recently updated first
+}
+
+func (x *ListAnnotationsResponse) Reset() {
+	*x = ListAnnotationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnnotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnotationsResponse) ProtoMessage() {}
+
+func (x *ListAnnotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnnotationsResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListAnnotationsResponse) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// Verse of the day, picked per calendar date from a verse list or the verses
+// of a CustomRange so every client shows the same verse on the same date
+type VerseOfTheDayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List        string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`                                  // verse list name, i.e. encouragement or memory
+	CustomRange string `protobuf:"bytes,2,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"` // pick from the verses of a CustomRange instead of a list
+	Date        string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                                  // YYYY-MM-DD, blank for today; future dates are not given out
+	Timezone    string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                          // IANA name deciding what today is, blank for UTC
+	Translation string `protobuf:"bytes,5,opt,name=translation,proto3" json:"translation,omitempty"`
+	History     int32  `protobuf:"varint,6,opt,name=history,proto3" json:"history,omitempty"` // also return the verses of this many days before date
+}
+
+func (x *VerseOfTheDayRequest) Reset() {
+	*x = VerseOfTheDayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerseOfTheDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerseOfTheDayRequest) ProtoMessage() {}
+
+func (x *VerseOfTheDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerseOfTheDayRequest.ProtoReflect.Descriptor instead.
+func (*VerseOfTheDayRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *VerseOfTheDayRequest) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *VerseOfTheDayRequest) GetCustomRange() string {
+	if x != nil {
+		return x.CustomRange
+	}
+	return ""
+}
+
+func (x *VerseOfTheDayRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *VerseOfTheDayRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *VerseOfTheDayRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *VerseOfTheDayRequest) GetHistory() int32 {
+	if x != nil {
+		return x.History
+	}
+	return 0
+}
+
+type VerseOfTheDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date      string   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	List      string   `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	Reference string   `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	Verses    []*Verse `protobuf:"bytes,4,rep,name=verses,proto3" json:"verses,omitempty"`
+}
+
+func (x *VerseOfTheDay) Reset() {
+	*x = VerseOfTheDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerseOfTheDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerseOfTheDay) ProtoMessage() {}
+
+func (x *VerseOfTheDay) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerseOfTheDay.ProtoReflect.Descriptor instead.
+func (*VerseOfTheDay) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *VerseOfTheDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *VerseOfTheDay) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *VerseOfTheDay) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *VerseOfTheDay) GetVerses() []*Verse {
+	if x != nil {
+		return x.Verses
+	}
+	return nil
+}
+
+type VerseOfTheDayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VerseOfTheDay *VerseOfTheDay   `protobuf:"bytes,1,opt,name=verse_of_the_day,json=verseOfTheDay,proto3" json:"verse_of_the_day,omitempty"`
+	History       []*VerseOfTheDay `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"` // most recent first
+}
+
+func (x *VerseOfTheDayResponse) Reset() {
+	*x = VerseOfTheDayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerseOfTheDayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerseOfTheDayResponse) ProtoMessage() {}
+
+func (x *VerseOfTheDayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerseOfTheDayResponse.ProtoReflect.Descriptor instead.
+func (*VerseOfTheDayResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *VerseOfTheDayResponse) GetVerseOfTheDay() *VerseOfTheDay {
+	if x != nil {
+		return x.VerseOfTheDay
+	}
+	return nil
+}
+
+func (x *VerseOfTheDayResponse) GetHistory() []*VerseOfTheDay {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Memorization, a deck of passages per user reviewed on an SM-2 schedule
+type MemoryCard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reference    string  `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"` // display form of the passage, i.e. Romans 8:38-39
+	Translation  string  `protobuf:"bytes,3,opt,name=translation,proto3" json:"translation,omitempty"`
+	Ease         float64 `protobuf:"fixed64,4,opt,name=ease,proto3" json:"ease,omitempty"`                                    // SM-2 ease factor, 2.5 for a new passage
+	Interval     int32   `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`                             // days between the last review and the next
+	Repetitions  int32   `protobuf:"varint,6,opt,name=repetitions,proto3" json:"repetitions,omitempty"`                       // recalls in a row graded 3 or better
+	Lapses       int32   `protobuf:"varint,7,opt,name=lapses,proto3" json:"lapses,omitempty"`                                 // times forgotten after being learned
+	Due          int64   `protobuf:"varint,8,opt,name=due,proto3" json:"due,omitempty"`                                       // unix seconds of the next review
+	LastReviewed int64   `protobuf:"varint,9,opt,name=last_reviewed,json=lastReviewed,proto3" json:"last_reviewed,omitempty"` // 0 until the first review
+	Reviews      int32   `protobuf:"varint,10,opt,name=reviews,proto3" json:"reviews,omitempty"`
+	Created      int64   `protobuf:"varint,11,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *MemoryCard) Reset() {
+	*x = MemoryCard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryCard) ProtoMessage() {}
+
+func (x *MemoryCard) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryCard.ProtoReflect.Descriptor instead.
+func (*MemoryCard) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MemoryCard) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemoryCard) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *MemoryCard) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *MemoryCard) GetEase() float64 {
+	if x != nil {
+		return x.Ease
+	}
+	return 0
+}
+
+func (x *MemoryCard) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *MemoryCard) GetRepetitions() int32 {
+	if x != nil {
+		return x.Repetitions
+	}
+	return 0
+}
+
+func (x *MemoryCard) GetLapses() int32 {
+	if x != nil {
+		return x.Lapses
+	}
+	return 0
+}
+
+func (x *MemoryCard) GetDue() int64 {
+	if x != nil {
+		return x.Due
+	}
+	return 0
+}
+
+func (x *MemoryCard) GetLastReviewed() int64 {
+	if x != nil {
+		return x.LastReviewed
+	}
+	return 0
+}
+
+func (x *MemoryCard) GetReviews() int32 {
+	if x != nil {
+		return x.Reviews
+	}
+	return 0
+}
+
+func (x *MemoryCard) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+type AddMemoryVerseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference   string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"` // i.e. "Ps 119:11" or "Rom 8:38-39"
+	Translation string `protobuf:"bytes,2,opt,name=translation,proto3" json:"translation,omitempty"`
+}
+
+func (x *AddMemoryVerseRequest) Reset() {
+	*x = AddMemoryVerseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMemoryVerseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMemoryVerseRequest) ProtoMessage() {}
+
+func (x *AddMemoryVerseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMemoryVerseRequest.ProtoReflect.Descriptor instead.
+func (*AddMemoryVerseRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AddMemoryVerseRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *AddMemoryVerseRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+type MemoryCardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card *MemoryCard `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+}
+
+func (x *MemoryCardResponse) Reset() {
+	*x = MemoryCardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryCardResponse) ProtoMessage() {}
+
+func (x *MemoryCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryCardResponse.ProtoReflect.Descriptor instead.
+func (*MemoryCardResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MemoryCardResponse) GetCard() *MemoryCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type RemoveMemoryVerseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveMemoryVerseRequest) Reset() {
+	*x = RemoveMemoryVerseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMemoryVerseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemoryVerseRequest) ProtoMessage() {}
+
+func (x *RemoveMemoryVerseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemoryVerseRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemoryVerseRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RemoveMemoryVerseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveMemoryVerseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveMemoryVerseResponse) Reset() {
+	*x = RemoveMemoryVerseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMemoryVerseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemoryVerseResponse) ProtoMessage() {}
+
+func (x *RemoveMemoryVerseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemoryVerseResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMemoryVerseResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{70}
+}
+
+type MemoryDeckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DueOnly bool  `protobuf:"varint,1,opt,name=due_only,json=dueOnly,proto3" json:"due_only,omitempty"` // only the cards due for review now
+	Limit   int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                    // 0 for 100, at most 500
+}
+
+func (x *MemoryDeckRequest) Reset() {
+	*x = MemoryDeckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryDeckRequest) ProtoMessage() {}
+
+func (x *MemoryDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryDeckRequest.ProtoReflect.Descriptor instead.
+func (*MemoryDeckRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MemoryDeckRequest) GetDueOnly() bool {
+	if x != nil {
+		return x.DueOnly
+	}
+	return false
+}
+
+func (x *MemoryDeckRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type MemoryDeckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cards []*MemoryCard `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"` // soonest due first
+}
+
+func (x *MemoryDeckResponse) Reset() {
+	*x = MemoryDeckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryDeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryDeckResponse) ProtoMessage() {}
+
+func (x *MemoryDeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryDeckResponse.ProtoReflect.Descriptor instead.
+func (*MemoryDeckResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MemoryDeckResponse) GetCards() []*MemoryCard {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type MemoryPromptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mode       string  `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`               // cloze (default), first_letter or full
+	Difficulty float64 `protobuf:"fixed64,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"` // share of words a cloze hides, 0 for 0.3
+	Seed       int64   `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`              // picks the hidden words, 0 for a new pick each time
+}
+
+func (x *MemoryPromptRequest) Reset() {
+	*x = MemoryPromptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryPromptRequest) ProtoMessage() {}
+
+func (x *MemoryPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryPromptRequest.ProtoReflect.Descriptor instead.
+func (*MemoryPromptRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *MemoryPromptRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemoryPromptRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MemoryPromptRequest) GetDifficulty() float64 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *MemoryPromptRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type MemoryPromptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card        *MemoryCard `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Prompt      string      `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	HiddenWords int32       `protobuf:"varint,3,opt,name=hidden_words,json=hiddenWords,proto3" json:"hidden_words,omitempty"` // blanks in a cloze prompt
+}
+
+func (x *MemoryPromptResponse) Reset() {
+	*x = MemoryPromptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryPromptResponse) ProtoMessage() {}
+
+func (x *MemoryPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryPromptResponse.ProtoReflect.Descriptor instead.
+func (*MemoryPromptResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MemoryPromptResponse) GetCard() *MemoryCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *MemoryPromptResponse) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *MemoryPromptResponse) GetHiddenWords() int32 {
+	if x != nil {
+		return x.HiddenWords
+	}
+	return 0
+}
+
+type ReviewMemoryVerseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Answer  string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`    // the passage typed from memory
+	Quality int32  `protobuf:"varint,3,opt,name=quality,proto3" json:"quality,omitempty"` // self grade 0-5, used when no answer is typed
+}
+
+func (x *ReviewMemoryVerseRequest) Reset() {
+	*x = ReviewMemoryVerseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewMemoryVerseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewMemoryVerseRequest) ProtoMessage() {}
+
+func (x *ReviewMemoryVerseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewMemoryVerseRequest.ProtoReflect.Descriptor instead.
+func (*ReviewMemoryVerseRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReviewMemoryVerseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewMemoryVerseRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *ReviewMemoryVerseRequest) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+type ReviewMemoryVerseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card        *MemoryCard `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Score       float64     `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`    // share of words recalled, 1 for a perfect recall
+	Quality     int32       `protobuf:"varint,3,opt,name=quality,proto3" json:"quality,omitempty"` // SM-2 grade the review was scheduled with
+	MissedWords []string    `protobuf:"bytes,4,rep,name=missed_words,json=missedWords,proto3" json:"missed_words,omitempty"`
+	Text        string      `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"` // the passage, to compare with the answer
+}
+
+func (x *ReviewMemoryVerseResponse) Reset() {
+	*x = ReviewMemoryVerseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewMemoryVerseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewMemoryVerseResponse) ProtoMessage() {}
+
+func (x *ReviewMemoryVerseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewMemoryVerseResponse.ProtoReflect.Descriptor instead.
+func (*ReviewMemoryVerseResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ReviewMemoryVerseResponse) GetCard() *MemoryCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *ReviewMemoryVerseResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ReviewMemoryVerseResponse) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *ReviewMemoryVerseResponse) GetMissedWords() []string {
+	if x != nil {
+		return x.MissedWords
+	}
+	return nil
+}
+
+func (x *ReviewMemoryVerseResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type MemoryProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MemoryProgressRequest) Reset() {
+	*x = MemoryProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryProgressRequest) ProtoMessage() {}
+
+func (x *MemoryProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryProgressRequest.ProtoReflect.Descriptor instead.
+func (*MemoryProgressRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{77}
+}
+
+type MemoryProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cards       int32   `protobuf:"varint,1,opt,name=cards,proto3" json:"cards,omitempty"`
+	Due         int32   `protobuf:"varint,2,opt,name=due,proto3" json:"due,omitempty"`           // due for review now
+	New         int32   `protobuf:"varint,3,opt,name=new,proto3" json:"new,omitempty"`           // never reviewed
+	Learning    int32   `protobuf:"varint,4,opt,name=learning,proto3" json:"learning,omitempty"` // reviewed, interval under 21 days
+	Mature      int32   `protobuf:"varint,5,opt,name=mature,proto3" json:"mature,omitempty"`     // interval of 21 days or more
+	Reviews     int32   `protobuf:"varint,6,opt,name=reviews,proto3" json:"reviews,omitempty"`
+	Lapses      int32   `protobuf:"varint,7,opt,name=lapses,proto3" json:"lapses,omitempty"`
+	AverageEase float64 `protobuf:"fixed64,8,opt,name=average_ease,json=averageEase,proto3" json:"average_ease,omitempty"`
+}
+
+func (x *MemoryProgressResponse) Reset() {
+	*x = MemoryProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryProgressResponse) ProtoMessage() {}
+
+func (x *MemoryProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryProgressResponse.ProtoReflect.Descriptor instead.
+func (*MemoryProgressResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MemoryProgressResponse) GetCards() int32 {
+	if x != nil {
+		return x.Cards
+	}
+	return 0
+}
+
+func (x *MemoryProgressResponse) GetDue() int32 {
+	if x != nil {
+		return x.Due
+	}
+	return 0
+}
+
+func (x *MemoryProgressResponse) GetNew() int32 {
+	if x != nil {
+		return x.New
+	}
+	return 0
+}
+
+func (x *MemoryProgressResponse) GetLearning() int32 {
+	if x != nil {
+		return x.Learning
+	}
+	return 0
+}
+
+func (x *MemoryProgressResponse) GetMature() int32 {
+	if x != nil {
+		return x.Mature
+	}
+	return 0
+}
+
+func (x *MemoryProgressResponse) GetReviews() int32 {
+	if x != nil {
+		return x.Reviews
+	}
+	return 0
+}
+
+func (x *MemoryProgressResponse) GetLapses() int32 {
+	if x != nil {
+		return x.Lapses
+	}
+	return 0
+}
+
+func (x *MemoryProgressResponse) GetAverageEase() float64 {
+	if x != nil {
+		return x.AverageEase
+	}
+	return 0
+}
+
+// Word search puzzles made from the significant words of a passage
+type WordSearchPuzzleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference   string   `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`                        // i.e. "Ps 23", or
+	CustomRange string   `protobuf:"bytes,2,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"` // the verses of a CustomRange
+	Translation string   `protobuf:"bytes,3,opt,name=translation,proto3" json:"translation,omitempty"`
+	Size        int32    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                            // rows and columns, 5 to 30, 0 for 12
+	Directions  []string `protobuf:"bytes,5,rep,name=directions,proto3" json:"directions,omitempty"`                 // across, down and/or diagonal, all of them when empty
+	Backwards   bool     `protobuf:"varint,6,opt,name=backwards,proto3" json:"backwards,omitempty"`                  // also hide words reversed
+	MinLength   int32    `protobuf:"varint,7,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"` // shortest word used, 0 for 4
+	MaxWords    int32    `protobuf:"varint,8,opt,name=max_words,json=maxWords,proto3" json:"max_words,omitempty"`    // 0 for 12
+	Seed        int64    `protobuf:"varint,9,opt,name=seed,proto3" json:"seed,omitempty"`                            // the same seed and options give the same puzzle, 0 for a new one
+}
+
+func (x *WordSearchPuzzleRequest) Reset() {
+	*x = WordSearchPuzzleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WordSearchPuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordSearchPuzzleRequest) ProtoMessage() {}
+
+func (x *WordSearchPuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordSearchPuzzleRequest.ProtoReflect.Descriptor instead.
+func (*WordSearchPuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *WordSearchPuzzleRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *WordSearchPuzzleRequest) GetCustomRange() string {
+	if x != nil {
+		return x.CustomRange
+	}
+	return ""
+}
+
+func (x *WordSearchPuzzleRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *WordSearchPuzzleRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *WordSearchPuzzleRequest) GetDirections() []string {
+	if x != nil {
+		return x.Directions
+	}
+	return nil
+}
+
+func (x *WordSearchPuzzleRequest) GetBackwards() bool {
+	if x != nil {
+		return x.Backwards
+	}
+	return false
+}
+
+func (x *WordSearchPuzzleRequest) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *WordSearchPuzzleRequest) GetMaxWords() int32 {
+	if x != nil {
+		return x.MaxWords
+	}
+	return 0
+}
+
+func (x *WordSearchPuzzleRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type PuzzleWord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word      string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Row       int32  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"` // first letter, counted from 0 at the top left
+	Column    int32  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	EndRow    int32  `protobuf:"varint,4,opt,name=end_row,json=endRow,proto3" json:"end_row,omitempty"` // last letter
+	EndColumn int32  `protobuf:"varint,5,opt,name=end_column,json=endColumn,proto3" json:"end_column,omitempty"`
+}
+
+func (x *PuzzleWord) Reset() {
+	*x = PuzzleWord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PuzzleWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleWord) ProtoMessage() {}
+
+func (x *PuzzleWord) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleWord.ProtoReflect.Descriptor instead.
+func (*PuzzleWord) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PuzzleWord) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *PuzzleWord) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *PuzzleWord) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *PuzzleWord) GetEndRow() int32 {
+	if x != nil {
+		return x.EndRow
+	}
+	return 0
+}
+
+func (x *PuzzleWord) GetEndColumn() int32 {
+	if x != nil {
+		return x.EndColumn
+	}
+	return 0
+}
+
+type WordSearchPuzzleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grid      []string      `protobuf:"bytes,1,rep,name=grid,proto3" json:"grid,omitempty"`   // the rows of letters
+	Words     []string      `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"` // the words to find, alphabetically
+	Solutions []*PuzzleWord `protobuf:"bytes,3,rep,name=solutions,proto3" json:"solutions,omitempty"`
+	Skipped   []string      `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"` // words that did not fit the grid
+	Reference string        `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Verses    []*Verse      `protobuf:"bytes,6,rep,name=verses,proto3" json:"verses,omitempty"`
+	Seed      int64         `protobuf:"varint,7,opt,name=seed,proto3" json:"seed,omitempty"` // the seed used, to print the same puzzle again
+}
+
+func (x *WordSearchPuzzleResponse) Reset() {
+	*x = WordSearchPuzzleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WordSearchPuzzleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordSearchPuzzleResponse) ProtoMessage() {}
+
+func (x *WordSearchPuzzleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordSearchPuzzleResponse.ProtoReflect.Descriptor instead.
+func (*WordSearchPuzzleResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *WordSearchPuzzleResponse) GetGrid() []string {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *WordSearchPuzzleResponse) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *WordSearchPuzzleResponse) GetSolutions() []*PuzzleWord {
+	if x != nil {
+		return x.Solutions
+	}
+	return nil
+}
+
+func (x *WordSearchPuzzleResponse) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *WordSearchPuzzleResponse) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *WordSearchPuzzleResponse) GetVerses() []*Verse {
+	if x != nil {
+		return x.Verses
+	}
+	return nil
+}
+
+func (x *WordSearchPuzzleResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// Quizzes of fill in the blank and "which book is this verse from" questions
+// on the verses of a scope. A quiz is not stored: the same request, seed
+// included, always gives the same questions, so CheckQuiz regenerates it.
+type QuizRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Books       []int32  `protobuf:"varint,1,rep,packed,name=books,proto3" json:"books,omitempty"` // the scope, books, a testament or a CustomRange,
+	Testament   string   `protobuf:"bytes,2,opt,name=testament,proto3" json:"testament,omitempty"` // the whole Bible when none is given
+	CustomRange string   `protobuf:"bytes,3,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"`
+	Translation string   `protobuf:"bytes,4,opt,name=translation,proto3" json:"translation,omitempty"`
+	Questions   int32    `protobuf:"varint,5,opt,name=questions,proto3" json:"questions,omitempty"` // 1 to 50, 0 for 10
+	Types       []string `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`          // fill_blank and/or which_book, both when empty
+	Choices     int32    `protobuf:"varint,7,opt,name=choices,proto3" json:"choices,omitempty"`     // choices per question, 2 to 6, 0 for 4
+	Seed        int64    `protobuf:"varint,8,opt,name=seed,proto3" json:"seed,omitempty"`           // 0 for a new quiz, the seed used is returned
+}
+
+func (x *QuizRequest) Reset() {
+	*x = QuizRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizRequest) ProtoMessage() {}
+
+func (x *QuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizRequest.ProtoReflect.Descriptor instead.
+func (*QuizRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *QuizRequest) GetBooks() []int32 {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+func (x *QuizRequest) GetTestament() string {
+	if x != nil {
+		return x.Testament
+	}
+	return ""
+}
+
+func (x *QuizRequest) GetCustomRange() string {
+	if x != nil {
+		return x.CustomRange
+	}
+	return ""
+}
+
+func (x *QuizRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *QuizRequest) GetQuestions() int32 {
+	if x != nil {
+		return x.Questions
+	}
+	return 0
+}
+
+func (x *QuizRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *QuizRequest) GetChoices() int32 {
+	if x != nil {
+		return x.Choices
+	}
+	return 0
+}
+
+func (x *QuizRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type QuizQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number    int32    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"` // from 1
+	Type      string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`      // fill_blank or which_book
+	Prompt    string   `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`  // the verse, with a blank for fill_blank
+	Choices   []string `protobuf:"bytes,4,rep,name=choices,proto3" json:"choices,omitempty"`
+	Reference string   `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"` // the verse of a fill_blank question, blank for which_book
+}
+
+func (x *QuizQuestion) Reset() {
+	*x = QuizQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizQuestion) ProtoMessage() {}
+
+func (x *QuizQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizQuestion.ProtoReflect.Descriptor instead.
+func (*QuizQuestion) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *QuizQuestion) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QuizQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *QuizQuestion) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *QuizQuestion) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type QuizResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questions []*QuizQuestion `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Seed      int64           `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *QuizResponse) Reset() {
+	*x = QuizResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizResponse) ProtoMessage() {}
+
+func (x *QuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizResponse.ProtoReflect.Descriptor instead.
+func (*QuizResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *QuizResponse) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *QuizResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type CheckQuizRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quiz    *QuizRequest `protobuf:"bytes,1,opt,name=quiz,proto3" json:"quiz,omitempty"`       // the request the quiz was made with, seed included
+	Answers []string     `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"` // in question order, a choice or a typed word
+}
+
+func (x *CheckQuizRequest) Reset() {
+	*x = CheckQuizRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuizRequest) ProtoMessage() {}
+
+func (x *CheckQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuizRequest.ProtoReflect.Descriptor instead.
+func (*CheckQuizRequest) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CheckQuizRequest) GetQuiz() *QuizRequest {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+func (x *CheckQuizRequest) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type QuizResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number        int32  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Correct       bool   `protobuf:"varint,2,opt,name=correct,proto3" json:"correct,omitempty"`
+	Answer        string `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	CorrectAnswer string `protobuf:"bytes,4,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"`
+	Reference     string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *QuizResult) Reset() {
+	*x = QuizResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizResult) ProtoMessage() {}
+
+func (x *QuizResult) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizResult.ProtoReflect.Descriptor instead.
+func (*QuizResult) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *QuizResult) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *QuizResult) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *QuizResult) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *QuizResult) GetCorrectAnswer() string {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return ""
+}
+
+func (x *QuizResult) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type CheckQuizResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Correct int32         `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	Total   int32         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Score   float64       `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"` // share of questions answered correctly
+	Results []*QuizResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *CheckQuizResponse) Reset() {
+	*x = CheckQuizResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wspb_ws_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuizResponse) ProtoMessage() {}
+
+func (x *CheckQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wspb_ws_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuizResponse.ProtoReflect.Descriptor instead.
+func (*CheckQuizResponse) Descriptor() ([]byte, []int) {
+	return file_wspb_ws_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CheckQuizResponse) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *CheckQuizResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CheckQuizResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CheckQuizResponse) GetResults() []*QuizResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_wspb_ws_proto protoreflect.FileDescriptor
+
+var file_wspb_ws_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x77, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0c, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x22, 0xf9, 0x02,
+	0x0a, 0x05, 0x56, 0x65, 0x72, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x6f, 0x6f, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62,
+	0x6f, 0x6f, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x53, 0x70, 0x61, 0x6e, 0x52, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x68,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x66, 0x6f, 0x6f, 0x74, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6f, 0x74, 0x6e, 0x6f,
+	0x74, 0x65, 0x52, 0x09, 0x66, 0x6f, 0x6f, 0x74, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a,
+	0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4a, 0x0a, 0x08, 0x46, 0x6f, 0x6f,
+	0x74, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x42, 0x0a, 0x04, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x34, 0x0a, 0x04, 0x57, 0x6f, 0x72,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x73, 0x22,
+	0xfa, 0x01, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x6f, 0x6f, 0x74, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x46, 0x6f, 0x6f, 0x74, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3c, 0x0a, 0x0d,
+	0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x65, 0x52, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x09, 0x42, 0x69,
+	0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0x26, 0x0a, 0x10, 0x42, 0x69, 0x62, 0x6c, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x4b, 0x0a, 0x11, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x62, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x6c,
+	0x61, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61,
+	0x6e, 0x52, 0x09, 0x62, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0xc0, 0x01, 0x0a,
+	0x0c, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x32, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x33, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x33, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x34, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x34, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22,
+	0x3b, 0x0a, 0x13, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x44, 0x0a, 0x14,
+	0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x52, 0x03, 0x64,
+	0x61, 0x79, 0x22, 0x67, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x11, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x12, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x0a, 0x62, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x09, 0x62,
+	0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x61, 0x76,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x61, 0x76, 0x65, 0x64, 0x22,
+	0x3f, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x22, 0x28, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7f, 0x0a, 0x0e, 0x50, 0x6c,
+	0x61, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x22, 0xc1, 0x02, 0x0a, 0x13,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65,
+	0x65, 0x70, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x6b, 0x65, 0x65, 0x70, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x73, 0x61, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22,
+	0x7f, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x62, 0x69, 0x62, 0x6c, 0x65,
+	0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x09, 0x62, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x2f, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42,
+	0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x22, 0xd1, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x5f,
+	0x6f, 0x66, 0x5f, 0x63, 0x68, 0x72, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x4f, 0x66, 0x43, 0x68, 0x72, 0x69, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66,
+	0x6f, 0x6f, 0x74, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x6f, 0x6f, 0x74, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xd1, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x66, 0x6f, 0x6f, 0x74, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x6f, 0x6f, 0x74, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x77, 0x0a, 0x0b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x28,
+	0x0a, 0x12, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x13, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x8b, 0x03,
+	0x0a, 0x0e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x21, 0x0a,
+	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x74, 0x6f, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x63,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f,
+	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x6f, 0x56, 0x65, 0x72,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x6f,
+	0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x45, 0x6e, 0x64, 0x42, 0x6f,
+	0x6f, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x45, 0x6e,
+	0x64, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x6f, 0x45, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2b,
+	0x0a, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x65, 0x52, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x16,
+	0x43, 0x72, 0x6f, 0x73, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x65,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45,
+	0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x62, 0x0a, 0x17, 0x43, 0x72, 0x6f, 0x73,
+	0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x63, 0x72, 0x6f,
+	0x73, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x8d, 0x02, 0x0a,
+	0x0c, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x6d, 0x6d, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x6d, 0x6d, 0x61, 0x12, 0x28, 0x0a,
+	0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6e, 0x75,
+	0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x6b, 0x6a, 0x76, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6b, 0x6a, 0x76, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x0e,
+	0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x73, 0x22, 0x43, 0x0a, 0x0f, 0x4c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xb8, 0x01,
+	0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x6d, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x65, 0x6d, 0x6d, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x6f,
+	0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6e,
+	0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x6c, 0x6f, 0x73,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x07, 0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x22, 0xf1, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x72, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x6f,
+	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2d, 0x0a,
+	0x07, 0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x22, 0x80, 0x01, 0x0a,
+	0x12, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x22,
+	0x4d, 0x0a, 0x13, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x61,
+	0x72, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x22, 0xd6,
+	0x01, 0x0a, 0x08, 0x50, 0x65, 0x72, 0x69, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x69, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x49, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x69, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x69, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x63, 0x6f, 0x70,
+	0x65, 0x52, 0x09, 0x70, 0x65, 0x72, 0x69, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x04,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x5f, 0x0a, 0x0f,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x46, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x66, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x0b, 0x0a,
+	0x09, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x0c, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0x6f, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x6c, 0x61, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64,
+	0x61, 0x79, 0x73, 0x22, 0x78, 0x0a, 0x0d, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0x4a, 0x0a,
+	0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x65, 0x0a, 0x13, 0x4d, 0x61, 0x72,
+	0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x6c, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x6e, 0x64, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x6e, 0x64, 0x6f,
+	0x22, 0x29, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0xed, 0x01, 0x0a, 0x0c,
+	0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x14, 0x50,
+	0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x38, 0x0a, 0x0e, 0x43,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6c, 0x61,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0x4f, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x69, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55,
+	0x70, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x44, 0x61, 0x79, 0x52, 0x04, 0x64, 0x61,
+	0x79, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x0a, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64,
+	0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65,
+	0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x4d, 0x0a, 0x11, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x12, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x01,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x55, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x14,
+	0x56, 0x65, 0x72, 0x73, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x65, 0x44, 0x61, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73,
+	0x65, 0x4f, 0x66, 0x54, 0x68, 0x65, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x2b, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x65, 0x52, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x22, 0x94, 0x01, 0x0a,
+	0x15, 0x56, 0x65, 0x72, 0x73, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x65, 0x44, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f,
+	0x6f, 0x66, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x56, 0x65, 0x72, 0x73, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x65, 0x44, 0x61, 0x79, 0x52, 0x0d, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x65, 0x44, 0x61, 0x79, 0x12, 0x35, 0x0a, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x65, 0x44, 0x61, 0x79, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x22, 0xb1, 0x02, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x61,
+	0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x64, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64, 0x75, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x57, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x42, 0x0a, 0x12, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04,
+	0x63, 0x61, 0x72, 0x64, 0x22, 0x2a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a,
+	0x11, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x75, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x44, 0x0a, 0x12, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x6d, 0x0a, 0x13, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0x7f, 0x0a, 0x14, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x68, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x5c, 0x0a, 0x18, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xb0, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x63,
+	0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x16, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x64, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x61, 0x73, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x45, 0x61, 0x73,
+	0x65, 0x22, 0x9e, 0x02, 0x0a, 0x17, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x0a, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x57, 0x6f, 0x72,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x6e,
+	0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0xf5, 0x01, 0x0a, 0x18, 0x57, 0x6f, 0x72, 0x64,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x36,
+	0x0a, 0x09, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x09, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2b,
+	0x0a, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x65, 0x52, 0x06, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22,
+	0xe8, 0x01, 0x0a, 0x0b, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05,
+	0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x51,
+	0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x5c, 0x0a, 0x0c, 0x51, 0x75, 0x69, 0x7a, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0x5b, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75,
+	0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x71, 0x75, 0x69,
+	0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x0a, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x22, 0x8d, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x69,
+	0x7a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x32, 0x96, 0x17, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x56, 0x65, 0x72, 0x73,
+	0x65, 0x12, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x06,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42,
+	0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42,
+	0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x44,
+	0x61, 0x79, 0x12, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x44, 0x61,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0a, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x57, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x6e, 0x12, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x09, 0x42, 0x6f,
+	0x6f, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60,
+	0x0a, 0x0f, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x24, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x07, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x20, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69,
+	0x6e, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x09, 0x50, 0x65, 0x72, 0x69, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x72,
+	0x69, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x72,
+	0x69, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x47, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x05, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x02,
+	0x4d, 0x65, 0x12, 0x17, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x06, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x12, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x07, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x12, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x57, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x60, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x24, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x65, 0x44,
+	0x61, 0x79, 0x12, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x65, 0x44, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x65,
+	0x44, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x65, 0x12,
+	0x23, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41,
+	0x64, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x65, 0x12, 0x26, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x51, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x63, 0x6b, 0x12, 0x1f,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x12, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73,
+	0x65, 0x12, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f,
+	0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x04, 0x51, 0x75, 0x69, 0x7a,
+	0x12, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x69, 0x7a, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x69, 0x7a, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x22, 0x5a, 0x20, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_wspb_ws_proto_rawDescOnce sync.Once
+	file_wspb_ws_proto_rawDescData = file_wspb_ws_proto_rawDesc
+)
+
+func file_wspb_ws_proto_rawDescGZIP() []byte {
+	file_wspb_ws_proto_rawDescOnce.Do(func() {
+		file_wspb_ws_proto_rawDescData = protoimpl.X.CompressGZIP(file_wspb_ws_proto_rawDescData)
+	})
+	return file_wspb_ws_proto_rawDescData
+}
+
+var file_wspb_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
+var file_wspb_ws_proto_goTypes = []interface{}{
+	(*Verse)(nil),                     // 0: wordsearcher.Verse
+	(*Footnote)(nil),                  // 1: wordsearcher.Footnote
+	(*Span)(nil),                      // 2: wordsearcher.Span
+	(*Word)(nil),                      // 3: wordsearcher.Word
+	(*VerseRequest)(nil),              // 4: wordsearcher.VerseRequest
+	(*VerseResponse)(nil),             // 5: wordsearcher.VerseResponse
+	(*BiblePlan)(nil),                 // 6: wordsearcher.BiblePlan
+	(*BiblePlanRequest)(nil),          // 7: wordsearcher.BiblePlanRequest
+	(*BiblePlanResponse)(nil),         // 8: wordsearcher.BiblePlanResponse
+	(*BiblePlanDay)(nil),              // 9: wordsearcher.BiblePlanDay
+	(*BiblePlanDayRequest)(nil),       // 10: wordsearcher.BiblePlanDayRequest
+	(*BiblePlanDayResponse)(nil),      // 11: wordsearcher.BiblePlanDayResponse
+	(*PlanError)(nil),                 // 12: wordsearcher.PlanError
+	(*ImportPlanRequest)(nil),         // 13: wordsearcher.ImportPlanRequest
+	(*ImportPlanResponse)(nil),        // 14: wordsearcher.ImportPlanResponse
+	(*ExportPlanRequest)(nil),         // 15: wordsearcher.ExportPlanRequest
+	(*ExportPlanResponse)(nil),        // 16: wordsearcher.ExportPlanResponse
+	(*PlanTrackScope)(nil),            // 17: wordsearcher.PlanTrackScope
+	(*GeneratePlanRequest)(nil),       // 18: wordsearcher.GeneratePlanRequest
+	(*GeneratePlanResponse)(nil),      // 19: wordsearcher.GeneratePlanResponse
+	(*SearchRequest)(nil),             // 20: wordsearcher.SearchRequest
+	(*BookRangeRequest)(nil),          // 21: wordsearcher.BookRangeRequest
+	(*ChapterRangeRequest)(nil),       // 22: wordsearcher.ChapterRangeRequest
+	(*CustomRange)(nil),               // 23: wordsearcher.CustomRange
+	(*CustomRangeRequest)(nil),        // 24: wordsearcher.CustomRangeRequest
+	(*CustomRangeResponse)(nil),       // 25: wordsearcher.CustomRangeResponse
+	(*CrossReference)(nil),            // 26: wordsearcher.CrossReference
+	(*CrossReferencesRequest)(nil),    // 27: wordsearcher.CrossReferencesRequest
+	(*CrossReferencesResponse)(nil),   // 28: wordsearcher.CrossReferencesResponse
+	(*LexiconEntry)(nil),              // 29: wordsearcher.LexiconEntry
+	(*LexiconRequest)(nil),            // 30: wordsearcher.LexiconRequest
+	(*LexiconResponse)(nil),           // 31: wordsearcher.LexiconResponse
+	(*InterlinearToken)(nil),          // 32: wordsearcher.InterlinearToken
+	(*InterlinearVerse)(nil),          // 33: wordsearcher.InterlinearVerse
+	(*InterlinearRequest)(nil),        // 34: wordsearcher.InterlinearRequest
+	(*InterlinearResponse)(nil),       // 35: wordsearcher.InterlinearResponse
+	(*Pericope)(nil),                  // 36: wordsearcher.Pericope
+	(*PericopesRequest)(nil),          // 37: wordsearcher.PericopesRequest
+	(*PericopesResponse)(nil),         // 38: wordsearcher.PericopesResponse
+	(*User)(nil),                      // 39: wordsearcher.User
+	(*RegisterRequest)(nil),           // 40: wordsearcher.RegisterRequest
+	(*LoginRequest)(nil),              // 41: wordsearcher.LoginRequest
+	(*AuthResponse)(nil),              // 42: wordsearcher.AuthResponse
+	(*MeRequest)(nil),                 // 43: wordsearcher.MeRequest
+	(*UserResponse)(nil),              // 44: wordsearcher.UserResponse
+	(*Enrollment)(nil),                // 45: wordsearcher.Enrollment
+	(*EnrollRequest)(nil),             // 46: wordsearcher.EnrollRequest
+	(*EnrollResponse)(nil),            // 47: wordsearcher.EnrollResponse
+	(*MarkCompleteRequest)(nil),       // 48: wordsearcher.MarkCompleteRequest
+	(*PlanProgressRequest)(nil),       // 49: wordsearcher.PlanProgressRequest
+	(*PlanProgress)(nil),              // 50: wordsearcher.PlanProgress
+	(*PlanProgressResponse)(nil),      // 51: wordsearcher.PlanProgressResponse
+	(*CatchUpRequest)(nil),            // 52: wordsearcher.CatchUpRequest
+	(*PlanReading)(nil),               // 53: wordsearcher.PlanReading
+	(*CatchUpDay)(nil),                // 54: wordsearcher.CatchUpDay
+	(*CatchUpResponse)(nil),           // 55: wordsearcher.CatchUpResponse
+	(*Annotation)(nil),                // 56: wordsearcher.Annotation
+	(*AnnotationRequest)(nil),         // 57: wordsearcher.AnnotationRequest
+	(*AnnotationResponse)(nil),        // 58: wordsearcher.AnnotationResponse
+	(*DeleteAnnotationRequest)(nil),   // 59: wordsearcher.DeleteAnnotationRequest
+	(*DeleteAnnotationResponse)(nil),  // 60: wordsearcher.DeleteAnnotationResponse
+	(*ListAnnotationsRequest)(nil),    // 61: wordsearcher.ListAnnotationsRequest
+	(*ListAnnotationsResponse)(nil),   // 62: wordsearcher.ListAnnotationsResponse
+	(*VerseOfTheDayRequest)(nil),      // 63: wordsearcher.VerseOfTheDayRequest
+	(*VerseOfTheDay)(nil),             // 64: wordsearcher.VerseOfTheDay
+	(*VerseOfTheDayResponse)(nil),     // 65: wordsearcher.VerseOfTheDayResponse
+	(*MemoryCard)(nil),                // 66: wordsearcher.MemoryCard
+	(*AddMemoryVerseRequest)(nil),     // 67: wordsearcher.AddMemoryVerseRequest
+	(*MemoryCardResponse)(nil),        // 68: wordsearcher.MemoryCardResponse
+	(*RemoveMemoryVerseRequest)(nil),  // 69: wordsearcher.RemoveMemoryVerseRequest
+	(*RemoveMemoryVerseResponse)(nil), // 70: wordsearcher.RemoveMemoryVerseResponse
+	(*MemoryDeckRequest)(nil),         // 71: wordsearcher.MemoryDeckRequest
+	(*MemoryDeckResponse)(nil),        // 72: wordsearcher.MemoryDeckResponse
+	(*MemoryPromptRequest)(nil),       // 73: wordsearcher.MemoryPromptRequest
+	(*MemoryPromptResponse)(nil),      // 74: wordsearcher.MemoryPromptResponse
+	(*ReviewMemoryVerseRequest)(nil),  // 75: wordsearcher.ReviewMemoryVerseRequest
+	(*ReviewMemoryVerseResponse)(nil), // 76: wordsearcher.ReviewMemoryVerseResponse
+	(*MemoryProgressRequest)(nil),     // 77: wordsearcher.MemoryProgressRequest
+	(*MemoryProgressResponse)(nil),    // 78: wordsearcher.MemoryProgressResponse
+	(*WordSearchPuzzleRequest)(nil),   // 79: wordsearcher.WordSearchPuzzleRequest
+	(*PuzzleWord)(nil),                // 80: wordsearcher.PuzzleWord
+	(*WordSearchPuzzleResponse)(nil),  // 81: wordsearcher.WordSearchPuzzleResponse
+	(*QuizRequest)(nil),               // 82: wordsearcher.QuizRequest
+	(*QuizQuestion)(nil),              // 83: wordsearcher.QuizQuestion
+	(*QuizResponse)(nil),              // 84: wordsearcher.QuizResponse
+	(*CheckQuizRequest)(nil),          // 85: wordsearcher.CheckQuizRequest
+	(*QuizResult)(nil),                // 86: wordsearcher.QuizResult
+	(*CheckQuizResponse)(nil),         // 87: wordsearcher.CheckQuizResponse
+}
+var file_wspb_ws_proto_depIdxs = []int32{
+	3,  // 0: wordsearcher.Verse.words:type_name -> wordsearcher.Word
+	2,  // 1: wordsearcher.Verse.spans:type_name -> wordsearcher.Span
+	1,  // 2: wordsearcher.Verse.footnotes:type_name -> wordsearcher.Footnote
+	56, // 3: wordsearcher.Verse.annotations:type_name -> wordsearcher.Annotation
+	0,  // 4: wordsearcher.VerseResponse.verses:type_name -> wordsearcher.Verse
+	6,  // 5: wordsearcher.BiblePlanResponse.bible_plan:type_name -> wordsearcher.BiblePlan
+	9,  // 6: wordsearcher.BiblePlanDayResponse.day:type_name -> wordsearcher.BiblePlanDay
+	6,  // 7: wordsearcher.ImportPlanResponse.bible_plan:type_name -> wordsearcher.BiblePlan
+	12, // 8: wordsearcher.ImportPlanResponse.errors:type_name -> wordsearcher.PlanError
+	17, // 9: wordsearcher.GeneratePlanRequest.tracks:type_name -> wordsearcher.PlanTrackScope
+	6,  // 10: wordsearcher.GeneratePlanResponse.bible_plan:type_name -> wordsearcher.BiblePlan
+	6,  // 11: wordsearcher.GeneratePlanResponse.tracks:type_name -> wordsearcher.BiblePlan
+	23, // 12: wordsearcher.CustomRangeResponse.custom_range:type_name -> wordsearcher.CustomRange
+	0,  // 13: wordsearcher.CrossReference.verses:type_name -> wordsearcher.Verse
+	26, // 14: wordsearcher.CrossReferencesResponse.cross_references:type_name -> wordsearcher.CrossReference
+	29, // 15: wordsearcher.LexiconResponse.entry:type_name -> wordsearcher.LexiconEntry
+	32, // 16: wordsearcher.InterlinearVerse.tokens:type_name -> wordsearcher.InterlinearToken
+	0,  // 17: wordsearcher.InterlinearVerse.english:type_name -> wordsearcher.Verse
+	33, // 18: wordsearcher.InterlinearResponse.verses:type_name -> wordsearcher.InterlinearVerse
+	36, // 19: wordsearcher.PericopesResponse.pericopes:type_name -> wordsearcher.Pericope
+	39, // 20: wordsearcher.AuthResponse.user:type_name -> wordsearcher.User
+	39, // 21: wordsearcher.UserResponse.user:type_name -> wordsearcher.User
+	45, // 22: wordsearcher.EnrollResponse.enrollment:type_name -> wordsearcher.Enrollment
+	50, // 23: wordsearcher.PlanProgressResponse.progress:type_name -> wordsearcher.PlanProgress
+	53, // 24: wordsearcher.CatchUpDay.readings:type_name -> wordsearcher.PlanReading
+	54, // 25: wordsearcher.CatchUpResponse.days:type_name -> wordsearcher.CatchUpDay
+	56, // 26: wordsearcher.AnnotationRequest.annotation:type_name -> wordsearcher.Annotation
+	56, // 27: wordsearcher.AnnotationResponse.annotation:type_name -> wordsearcher.Annotation
+	56, // 28: wordsearcher.ListAnnotationsResponse.annotations:type_name -> wordsearcher.Annotation
+	0,  // 29: wordsearcher.VerseOfTheDay.verses:type_name -> wordsearcher.Verse
+	64, // 30: wordsearcher.VerseOfTheDayResponse.verse_of_the_day:type_name -> wordsearcher.VerseOfTheDay
+	64, // 31: wordsearcher.VerseOfTheDayResponse.history:type_name -> wordsearcher.VerseOfTheDay
+	66, // 32: wordsearcher.MemoryCardResponse.card:type_name -> wordsearcher.MemoryCard
+	66, // 33: wordsearcher.MemoryDeckResponse.cards:type_name -> wordsearcher.MemoryCard
+	66, // 34: wordsearcher.MemoryPromptResponse.card:type_name -> wordsearcher.MemoryCard
+	66, // 35: wordsearcher.ReviewMemoryVerseResponse.card:type_name -> wordsearcher.MemoryCard
+	80, // 36: wordsearcher.WordSearchPuzzleResponse.solutions:type_name -> wordsearcher.PuzzleWord
+	0,  // 37: wordsearcher.WordSearchPuzzleResponse.verses:type_name -> wordsearcher.Verse
+	83, // 38: wordsearcher.QuizResponse.questions:type_name -> wordsearcher.QuizQuestion
+	82, // 39: wordsearcher.CheckQuizRequest.quiz:type_name -> wordsearcher.QuizRequest
+	86, // 40: wordsearcher.CheckQuizResponse.results:type_name -> wordsearcher.QuizResult
+	4,  // 41: wordsearcher.WordsearcherService.Verse:input_type -> wordsearcher.VerseRequest
+	20, // 42: wordsearcher.WordsearcherService.Search:input_type -> wordsearcher.SearchRequest
+	7,  // 43: wordsearcher.WordsearcherService.BiblePlan:input_type -> wordsearcher.BiblePlanRequest
+	10, // 44: wordsearcher.WordsearcherService.BiblePlanDay:input_type -> wordsearcher.BiblePlanDayRequest
+	13, // 45: wordsearcher.WordsearcherService.ImportPlan:input_type -> wordsearcher.ImportPlanRequest
+	15, // 46: wordsearcher.WordsearcherService.ExportPlan:input_type -> wordsearcher.ExportPlanRequest
+	18, // 47: wordsearcher.WordsearcherService.GeneratePlan:input_type -> wordsearcher.GeneratePlanRequest
+	21, // 48: wordsearcher.WordsearcherService.BookRange:input_type -> wordsearcher.BookRangeRequest
+	22, // 49: wordsearcher.WordsearcherService.ChapterRange:input_type -> wordsearcher.ChapterRangeRequest
+	24, // 50: wordsearcher.WordsearcherService.CustomRange:input_type -> wordsearcher.CustomRangeRequest
+	27, // 51: wordsearcher.WordsearcherService.CrossReferences:input_type -> wordsearcher.CrossReferencesRequest
+	30, // 52: wordsearcher.WordsearcherService.Lexicon:input_type -> wordsearcher.LexiconRequest
+	34, // 53: wordsearcher.WordsearcherService.Interlinear:input_type -> wordsearcher.InterlinearRequest
+	37, // 54: wordsearcher.WordsearcherService.Pericopes:input_type -> wordsearcher.PericopesRequest
+	40, // 55: wordsearcher.WordsearcherService.Register:input_type -> wordsearcher.RegisterRequest
+	41, // 56: wordsearcher.WordsearcherService.Login:input_type -> wordsearcher.LoginRequest
+	43, // 57: wordsearcher.WordsearcherService.Me:input_type -> wordsearcher.MeRequest
+	46, // 58: wordsearcher.WordsearcherService.Enroll:input_type -> wordsearcher.EnrollRequest
+	48, // 59: wordsearcher.WordsearcherService.MarkComplete:input_type -> wordsearcher.MarkCompleteRequest
+	49, // 60: wordsearcher.WordsearcherService.Progress:input_type -> wordsearcher.PlanProgressRequest
+	52, // 61: wordsearcher.WordsearcherService.CatchUp:input_type -> wordsearcher.CatchUpRequest
+	57, // 62: wordsearcher.WordsearcherService.CreateAnnotation:input_type -> wordsearcher.AnnotationRequest
+	57, // 63: wordsearcher.WordsearcherService.UpdateAnnotation:input_type -> wordsearcher.AnnotationRequest
+	59, // 64: wordsearcher.WordsearcherService.DeleteAnnotation:input_type -> wordsearcher.DeleteAnnotationRequest
+	61, // 65: wordsearcher.WordsearcherService.ListAnnotations:input_type -> wordsearcher.ListAnnotationsRequest
+	63, // 66: wordsearcher.WordsearcherService.VerseOfTheDay:input_type -> wordsearcher.VerseOfTheDayRequest
+	67, // 67: wordsearcher.WordsearcherService.AddMemoryVerse:input_type -> wordsearcher.AddMemoryVerseRequest
+	69, // 68: wordsearcher.WordsearcherService.RemoveMemoryVerse:input_type -> wordsearcher.RemoveMemoryVerseRequest
+	71, // 69: wordsearcher.WordsearcherService.MemoryDeck:input_type -> wordsearcher.MemoryDeckRequest
+	73, // 70: wordsearcher.WordsearcherService.MemoryPrompt:input_type -> wordsearcher.MemoryPromptRequest
+	75, // 71: wordsearcher.WordsearcherService.ReviewMemoryVerse:input_type -> wordsearcher.ReviewMemoryVerseRequest
+	77, // 72: wordsearcher.WordsearcherService.MemoryProgress:input_type -> wordsearcher.MemoryProgressRequest
+	79, // 73: wordsearcher.WordsearcherService.WordSearchPuzzle:input_type -> wordsearcher.WordSearchPuzzleRequest
+	82, // 74: wordsearcher.WordsearcherService.Quiz:input_type -> wordsearcher.QuizRequest
+	85, // 75: wordsearcher.WordsearcherService.CheckQuiz:input_type -> wordsearcher.CheckQuizRequest
+	5,  // 76: wordsearcher.WordsearcherService.Verse:output_type -> wordsearcher.VerseResponse
+	5,  // 77: wordsearcher.WordsearcherService.Search:output_type -> wordsearcher.VerseResponse
+	8,  // 78: wordsearcher.WordsearcherService.BiblePlan:output_type -> wordsearcher.BiblePlanResponse
+	11, // 79: wordsearcher.WordsearcherService.BiblePlanDay:output_type -> wordsearcher.BiblePlanDayResponse
+	14, // 80: wordsearcher.WordsearcherService.ImportPlan:output_type -> wordsearcher.ImportPlanResponse
+	16, // 81: wordsearcher.WordsearcherService.ExportPlan:output_type -> wordsearcher.ExportPlanResponse
+	19, // 82: wordsearcher.WordsearcherService.GeneratePlan:output_type -> wordsearcher.GeneratePlanResponse
+	5,  // 83: wordsearcher.WordsearcherService.BookRange:output_type -> wordsearcher.VerseResponse
+	5,  // 84: wordsearcher.WordsearcherService.ChapterRange:output_type -> wordsearcher.VerseResponse
+	25, // 85: wordsearcher.WordsearcherService.CustomRange:output_type -> wordsearcher.CustomRangeResponse
+	28, // 86: wordsearcher.WordsearcherService.CrossReferences:output_type -> wordsearcher.CrossReferencesResponse
+	31, // 87: wordsearcher.WordsearcherService.Lexicon:output_type -> wordsearcher.LexiconResponse
+	35, // 88: wordsearcher.WordsearcherService.Interlinear:output_type -> wordsearcher.InterlinearResponse
+	38, // 89: wordsearcher.WordsearcherService.Pericopes:output_type -> wordsearcher.PericopesResponse
+	42, // 90: wordsearcher.WordsearcherService.Register:output_type -> wordsearcher.AuthResponse
+	42, // 91: wordsearcher.WordsearcherService.Login:output_type -> wordsearcher.AuthResponse
+	44, // 92: wordsearcher.WordsearcherService.Me:output_type -> wordsearcher.UserResponse
+	47, // 93: wordsearcher.WordsearcherService.Enroll:output_type -> wordsearcher.EnrollResponse
+	51, // 94: wordsearcher.WordsearcherService.MarkComplete:output_type -> wordsearcher.PlanProgressResponse
+	51, // 95: wordsearcher.WordsearcherService.Progress:output_type -> wordsearcher.PlanProgressResponse
+	55, // 96: wordsearcher.WordsearcherService.CatchUp:output_type -> wordsearcher.CatchUpResponse
+	58, // 97: wordsearcher.WordsearcherService.CreateAnnotation:output_type -> wordsearcher.AnnotationResponse
+	58, // 98: wordsearcher.WordsearcherService.UpdateAnnotation:output_type -> wordsearcher.AnnotationResponse
+	60, // 99: wordsearcher.WordsearcherService.DeleteAnnotation:output_type -> wordsearcher.DeleteAnnotationResponse
+	62, // 100: wordsearcher.WordsearcherService.ListAnnotations:output_type -> wordsearcher.ListAnnotationsResponse
+	65, // 101: wordsearcher.WordsearcherService.VerseOfTheDay:output_type -> wordsearcher.VerseOfTheDayResponse
+	68, // 102: wordsearcher.WordsearcherService.AddMemoryVerse:output_type -> wordsearcher.MemoryCardResponse
+	70, // 103: wordsearcher.WordsearcherService.RemoveMemoryVerse:output_type -> wordsearcher.RemoveMemoryVerseResponse
+	72, // 104: wordsearcher.WordsearcherService.MemoryDeck:output_type -> wordsearcher.MemoryDeckResponse
+	74, // 105: wordsearcher.WordsearcherService.MemoryPrompt:output_type -> wordsearcher.MemoryPromptResponse
+	76, // 106: wordsearcher.WordsearcherService.ReviewMemoryVerse:output_type -> wordsearcher.ReviewMemoryVerseResponse
+	78, // 107: wordsearcher.WordsearcherService.MemoryProgress:output_type -> wordsearcher.MemoryProgressResponse
+	81, // 108: wordsearcher.WordsearcherService.WordSearchPuzzle:output_type -> wordsearcher.WordSearchPuzzleResponse
+	84, // 109: wordsearcher.WordsearcherService.Quiz:output_type -> wordsearcher.QuizResponse
+	87, // 110: wordsearcher.WordsearcherService.CheckQuiz:output_type -> wordsearcher.CheckQuizResponse
+	76, // [76:111] is the sub-list for method output_type
+	41, // [41:76] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
+}
+
+func init() { file_wspb_ws_proto_init() }
+func file_wspb_ws_proto_init() {
+	if File_wspb_ws_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_wspb_ws_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Verse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Footnote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Span); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Word); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BiblePlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BiblePlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BiblePlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BiblePlanDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BiblePlanDayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BiblePlanDayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanTrackScope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratePlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratePlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChapterRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrossReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrossReferencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrossReferencesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterlinearToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterlinearVerse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterlinearRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterlinearResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pericope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PericopesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PericopesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkCompleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatchUpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanReading); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1168,8 +7841,8 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BiblePlanRequest); i {
+		file_wspb_ws_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatchUpDay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1180,8 +7853,8 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BiblePlanResponse); i {
+		file_wspb_ws_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatchUpResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1192,8 +7865,8 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BiblePlanDay); i {
+		file_wspb_ws_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Annotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1204,8 +7877,8 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BiblePlanDayRequest); i {
+		file_wspb_ws_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnotationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1216,8 +7889,8 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BiblePlanDayResponse); i {
+		file_wspb_ws_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnotationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1228,8 +7901,8 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
+		file_wspb_ws_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAnnotationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1240,8 +7913,8 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BookRangeRequest); i {
+		file_wspb_ws_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAnnotationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1252,8 +7925,8 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChapterRangeRequest); i {
+		file_wspb_ws_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnnotationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1264,8 +7937,8 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomRange); i {
+		file_wspb_ws_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnnotationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1276,8 +7949,8 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomRangeRequest); i {
+		file_wspb_ws_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerseOfTheDayRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1288,8 +7961,284 @@ func file_wspb_ws_proto_init() {
 				return nil
 			}
 		}
-		file_wspb_ws_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomRangeResponse); i {
+		file_wspb_ws_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerseOfTheDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerseOfTheDayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryCard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMemoryVerseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryCardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMemoryVerseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMemoryVerseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryDeckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryDeckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryPromptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryPromptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewMemoryVerseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewMemoryVerseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WordSearchPuzzleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PuzzleWord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WordSearchPuzzleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckQuizRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wspb_ws_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckQuizResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1307,7 +8256,7 @@ func file_wspb_ws_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_wspb_ws_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
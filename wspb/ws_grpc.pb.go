@@ -25,10 +25,48 @@ type WordsearcherServiceClient interface {
 	// Unary - Bible Plan
 	BiblePlan(ctx context.Context, in *BiblePlanRequest, opts ...grpc.CallOption) (*BiblePlanResponse, error)
 	BiblePlanDay(ctx context.Context, in *BiblePlanDayRequest, opts ...grpc.CallOption) (*BiblePlanDayResponse, error)
+	ImportPlan(ctx context.Context, in *ImportPlanRequest, opts ...grpc.CallOption) (*ImportPlanResponse, error)
+	ExportPlan(ctx context.Context, in *ExportPlanRequest, opts ...grpc.CallOption) (*ExportPlanResponse, error)
+	GeneratePlan(ctx context.Context, in *GeneratePlanRequest, opts ...grpc.CallOption) (*GeneratePlanResponse, error)
 	// Custom requests
 	BookRange(ctx context.Context, in *BookRangeRequest, opts ...grpc.CallOption) (*VerseResponse, error)
 	ChapterRange(ctx context.Context, in *ChapterRangeRequest, opts ...grpc.CallOption) (*VerseResponse, error)
 	CustomRange(ctx context.Context, in *CustomRangeRequest, opts ...grpc.CallOption) (*CustomRangeResponse, error)
+	// Unary - Cross References
+	CrossReferences(ctx context.Context, in *CrossReferencesRequest, opts ...grpc.CallOption) (*CrossReferencesResponse, error)
+	// Unary - Lexicon
+	Lexicon(ctx context.Context, in *LexiconRequest, opts ...grpc.CallOption) (*LexiconResponse, error)
+	// Unary - Interlinear
+	Interlinear(ctx context.Context, in *InterlinearRequest, opts ...grpc.CallOption) (*InterlinearResponse, error)
+	// Unary - Pericopes
+	Pericopes(ctx context.Context, in *PericopesRequest, opts ...grpc.CallOption) (*PericopesResponse, error)
+	// Unary - Users
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Me(ctx context.Context, in *MeRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// Unary - Plan progress
+	Enroll(ctx context.Context, in *EnrollRequest, opts ...grpc.CallOption) (*EnrollResponse, error)
+	MarkComplete(ctx context.Context, in *MarkCompleteRequest, opts ...grpc.CallOption) (*PlanProgressResponse, error)
+	Progress(ctx context.Context, in *PlanProgressRequest, opts ...grpc.CallOption) (*PlanProgressResponse, error)
+	CatchUp(ctx context.Context, in *CatchUpRequest, opts ...grpc.CallOption) (*CatchUpResponse, error)
+	// Unary - Annotations
+	CreateAnnotation(ctx context.Context, in *AnnotationRequest, opts ...grpc.CallOption) (*AnnotationResponse, error)
+	UpdateAnnotation(ctx context.Context, in *AnnotationRequest, opts ...grpc.CallOption) (*AnnotationResponse, error)
+	DeleteAnnotation(ctx context.Context, in *DeleteAnnotationRequest, opts ...grpc.CallOption) (*DeleteAnnotationResponse, error)
+	ListAnnotations(ctx context.Context, in *ListAnnotationsRequest, opts ...grpc.CallOption) (*ListAnnotationsResponse, error)
+	// Unary - Verse of the day
+	VerseOfTheDay(ctx context.Context, in *VerseOfTheDayRequest, opts ...grpc.CallOption) (*VerseOfTheDayResponse, error)
+	// Unary - Memorization
+	AddMemoryVerse(ctx context.Context, in *AddMemoryVerseRequest, opts ...grpc.CallOption) (*MemoryCardResponse, error)
+	RemoveMemoryVerse(ctx context.Context, in *RemoveMemoryVerseRequest, opts ...grpc.CallOption) (*RemoveMemoryVerseResponse, error)
+	MemoryDeck(ctx context.Context, in *MemoryDeckRequest, opts ...grpc.CallOption) (*MemoryDeckResponse, error)
+	MemoryPrompt(ctx context.Context, in *MemoryPromptRequest, opts ...grpc.CallOption) (*MemoryPromptResponse, error)
+	ReviewMemoryVerse(ctx context.Context, in *ReviewMemoryVerseRequest, opts ...grpc.CallOption) (*ReviewMemoryVerseResponse, error)
+	MemoryProgress(ctx context.Context, in *MemoryProgressRequest, opts ...grpc.CallOption) (*MemoryProgressResponse, error)
+	// Unary - Puzzles and quizzes
+	WordSearchPuzzle(ctx context.Context, in *WordSearchPuzzleRequest, opts ...grpc.CallOption) (*WordSearchPuzzleResponse, error)
+	Quiz(ctx context.Context, in *QuizRequest, opts ...grpc.CallOption) (*QuizResponse, error)
+	CheckQuiz(ctx context.Context, in *CheckQuizRequest, opts ...grpc.CallOption) (*CheckQuizResponse, error)
 }
 
 type wordsearcherServiceClient struct {
@@ -75,6 +113,33 @@ func (c *wordsearcherServiceClient) BiblePlanDay(ctx context.Context, in *BibleP
 	return out, nil
 }
 
+func (c *wordsearcherServiceClient) ImportPlan(ctx context.Context, in *ImportPlanRequest, opts ...grpc.CallOption) (*ImportPlanResponse, error) {
+	out := new(ImportPlanResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/ImportPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) ExportPlan(ctx context.Context, in *ExportPlanRequest, opts ...grpc.CallOption) (*ExportPlanResponse, error) {
+	out := new(ExportPlanResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/ExportPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) GeneratePlan(ctx context.Context, in *GeneratePlanRequest, opts ...grpc.CallOption) (*GeneratePlanResponse, error) {
+	out := new(GeneratePlanResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/GeneratePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *wordsearcherServiceClient) BookRange(ctx context.Context, in *BookRangeRequest, opts ...grpc.CallOption) (*VerseResponse, error) {
 	out := new(VerseResponse)
 	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/BookRange", in, out, opts...)
@@ -102,6 +167,231 @@ func (c *wordsearcherServiceClient) CustomRange(ctx context.Context, in *CustomR
 	return out, nil
 }
 
+func (c *wordsearcherServiceClient) CrossReferences(ctx context.Context, in *CrossReferencesRequest, opts ...grpc.CallOption) (*CrossReferencesResponse, error) {
+	out := new(CrossReferencesResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/CrossReferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) Lexicon(ctx context.Context, in *LexiconRequest, opts ...grpc.CallOption) (*LexiconResponse, error) {
+	out := new(LexiconResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/Lexicon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) Interlinear(ctx context.Context, in *InterlinearRequest, opts ...grpc.CallOption) (*InterlinearResponse, error) {
+	out := new(InterlinearResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/Interlinear", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) Pericopes(ctx context.Context, in *PericopesRequest, opts ...grpc.CallOption) (*PericopesResponse, error) {
+	out := new(PericopesResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/Pericopes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) Me(ctx context.Context, in *MeRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/Me", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) Enroll(ctx context.Context, in *EnrollRequest, opts ...grpc.CallOption) (*EnrollResponse, error) {
+	out := new(EnrollResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/Enroll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) MarkComplete(ctx context.Context, in *MarkCompleteRequest, opts ...grpc.CallOption) (*PlanProgressResponse, error) {
+	out := new(PlanProgressResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/MarkComplete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) Progress(ctx context.Context, in *PlanProgressRequest, opts ...grpc.CallOption) (*PlanProgressResponse, error) {
+	out := new(PlanProgressResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/Progress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) CatchUp(ctx context.Context, in *CatchUpRequest, opts ...grpc.CallOption) (*CatchUpResponse, error) {
+	out := new(CatchUpResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/CatchUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) CreateAnnotation(ctx context.Context, in *AnnotationRequest, opts ...grpc.CallOption) (*AnnotationResponse, error) {
+	out := new(AnnotationResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/CreateAnnotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) UpdateAnnotation(ctx context.Context, in *AnnotationRequest, opts ...grpc.CallOption) (*AnnotationResponse, error) {
+	out := new(AnnotationResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/UpdateAnnotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) DeleteAnnotation(ctx context.Context, in *DeleteAnnotationRequest, opts ...grpc.CallOption) (*DeleteAnnotationResponse, error) {
+	out := new(DeleteAnnotationResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/DeleteAnnotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) ListAnnotations(ctx context.Context, in *ListAnnotationsRequest, opts ...grpc.CallOption) (*ListAnnotationsResponse, error) {
+	out := new(ListAnnotationsResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/ListAnnotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) VerseOfTheDay(ctx context.Context, in *VerseOfTheDayRequest, opts ...grpc.CallOption) (*VerseOfTheDayResponse, error) {
+	out := new(VerseOfTheDayResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/VerseOfTheDay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) AddMemoryVerse(ctx context.Context, in *AddMemoryVerseRequest, opts ...grpc.CallOption) (*MemoryCardResponse, error) {
+	out := new(MemoryCardResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/AddMemoryVerse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) RemoveMemoryVerse(ctx context.Context, in *RemoveMemoryVerseRequest, opts ...grpc.CallOption) (*RemoveMemoryVerseResponse, error) {
+	out := new(RemoveMemoryVerseResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/RemoveMemoryVerse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) MemoryDeck(ctx context.Context, in *MemoryDeckRequest, opts ...grpc.CallOption) (*MemoryDeckResponse, error) {
+	out := new(MemoryDeckResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/MemoryDeck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) MemoryPrompt(ctx context.Context, in *MemoryPromptRequest, opts ...grpc.CallOption) (*MemoryPromptResponse, error) {
+	out := new(MemoryPromptResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/MemoryPrompt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) ReviewMemoryVerse(ctx context.Context, in *ReviewMemoryVerseRequest, opts ...grpc.CallOption) (*ReviewMemoryVerseResponse, error) {
+	out := new(ReviewMemoryVerseResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/ReviewMemoryVerse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) MemoryProgress(ctx context.Context, in *MemoryProgressRequest, opts ...grpc.CallOption) (*MemoryProgressResponse, error) {
+	out := new(MemoryProgressResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/MemoryProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) WordSearchPuzzle(ctx context.Context, in *WordSearchPuzzleRequest, opts ...grpc.CallOption) (*WordSearchPuzzleResponse, error) {
+	out := new(WordSearchPuzzleResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/WordSearchPuzzle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) Quiz(ctx context.Context, in *QuizRequest, opts ...grpc.CallOption) (*QuizResponse, error) {
+	out := new(QuizResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/Quiz", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordsearcherServiceClient) CheckQuiz(ctx context.Context, in *CheckQuizRequest, opts ...grpc.CallOption) (*CheckQuizResponse, error) {
+	out := new(CheckQuizResponse)
+	err := c.cc.Invoke(ctx, "/wordsearcher.WordsearcherService/CheckQuiz", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WordsearcherServiceServer is the server API for WordsearcherService service.
 // All implementations must embed UnimplementedWordsearcherServiceServer
 // for forward compatibility
@@ -113,10 +403,48 @@ type WordsearcherServiceServer interface {
 	// Unary - Bible Plan
 	BiblePlan(context.Context, *BiblePlanRequest) (*BiblePlanResponse, error)
 	BiblePlanDay(context.Context, *BiblePlanDayRequest) (*BiblePlanDayResponse, error)
+	ImportPlan(context.Context, *ImportPlanRequest) (*ImportPlanResponse, error)
+	ExportPlan(context.Context, *ExportPlanRequest) (*ExportPlanResponse, error)
+	GeneratePlan(context.Context, *GeneratePlanRequest) (*GeneratePlanResponse, error)
 	// Custom requests
 	BookRange(context.Context, *BookRangeRequest) (*VerseResponse, error)
 	ChapterRange(context.Context, *ChapterRangeRequest) (*VerseResponse, error)
 	CustomRange(context.Context, *CustomRangeRequest) (*CustomRangeResponse, error)
+	// Unary - Cross References
+	CrossReferences(context.Context, *CrossReferencesRequest) (*CrossReferencesResponse, error)
+	// Unary - Lexicon
+	Lexicon(context.Context, *LexiconRequest) (*LexiconResponse, error)
+	// Unary - Interlinear
+	Interlinear(context.Context, *InterlinearRequest) (*InterlinearResponse, error)
+	// Unary - Pericopes
+	Pericopes(context.Context, *PericopesRequest) (*PericopesResponse, error)
+	// Unary - Users
+	Register(context.Context, *RegisterRequest) (*AuthResponse, error)
+	Login(context.Context, *LoginRequest) (*AuthResponse, error)
+	Me(context.Context, *MeRequest) (*UserResponse, error)
+	// Unary - Plan progress
+	Enroll(context.Context, *EnrollRequest) (*EnrollResponse, error)
+	MarkComplete(context.Context, *MarkCompleteRequest) (*PlanProgressResponse, error)
+	Progress(context.Context, *PlanProgressRequest) (*PlanProgressResponse, error)
+	CatchUp(context.Context, *CatchUpRequest) (*CatchUpResponse, error)
+	// Unary - Annotations
+	CreateAnnotation(context.Context, *AnnotationRequest) (*AnnotationResponse, error)
+	UpdateAnnotation(context.Context, *AnnotationRequest) (*AnnotationResponse, error)
+	DeleteAnnotation(context.Context, *DeleteAnnotationRequest) (*DeleteAnnotationResponse, error)
+	ListAnnotations(context.Context, *ListAnnotationsRequest) (*ListAnnotationsResponse, error)
+	// Unary - Verse of the day
+	VerseOfTheDay(context.Context, *VerseOfTheDayRequest) (*VerseOfTheDayResponse, error)
+	// Unary - Memorization
+	AddMemoryVerse(context.Context, *AddMemoryVerseRequest) (*MemoryCardResponse, error)
+	RemoveMemoryVerse(context.Context, *RemoveMemoryVerseRequest) (*RemoveMemoryVerseResponse, error)
+	MemoryDeck(context.Context, *MemoryDeckRequest) (*MemoryDeckResponse, error)
+	MemoryPrompt(context.Context, *MemoryPromptRequest) (*MemoryPromptResponse, error)
+	ReviewMemoryVerse(context.Context, *ReviewMemoryVerseRequest) (*ReviewMemoryVerseResponse, error)
+	MemoryProgress(context.Context, *MemoryProgressRequest) (*MemoryProgressResponse, error)
+	// Unary - Puzzles and quizzes
+	WordSearchPuzzle(context.Context, *WordSearchPuzzleRequest) (*WordSearchPuzzleResponse, error)
+	Quiz(context.Context, *QuizRequest) (*QuizResponse, error)
+	CheckQuiz(context.Context, *CheckQuizRequest) (*CheckQuizResponse, error)
 	mustEmbedUnimplementedWordsearcherServiceServer()
 }
 
@@ -136,6 +464,15 @@ func (UnimplementedWordsearcherServiceServer) BiblePlan(context.Context, *BibleP
 func (UnimplementedWordsearcherServiceServer) BiblePlanDay(context.Context, *BiblePlanDayRequest) (*BiblePlanDayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BiblePlanDay not implemented")
 }
+func (UnimplementedWordsearcherServiceServer) ImportPlan(context.Context, *ImportPlanRequest) (*ImportPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPlan not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) ExportPlan(context.Context, *ExportPlanRequest) (*ExportPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPlan not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) GeneratePlan(context.Context, *GeneratePlanRequest) (*GeneratePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePlan not implemented")
+}
 func (UnimplementedWordsearcherServiceServer) BookRange(context.Context, *BookRangeRequest) (*VerseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BookRange not implemented")
 }
@@ -145,6 +482,81 @@ func (UnimplementedWordsearcherServiceServer) ChapterRange(context.Context, *Cha
 func (UnimplementedWordsearcherServiceServer) CustomRange(context.Context, *CustomRangeRequest) (*CustomRangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CustomRange not implemented")
 }
+func (UnimplementedWordsearcherServiceServer) CrossReferences(context.Context, *CrossReferencesRequest) (*CrossReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossReferences not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) Lexicon(context.Context, *LexiconRequest) (*LexiconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lexicon not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) Interlinear(context.Context, *InterlinearRequest) (*InterlinearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Interlinear not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) Pericopes(context.Context, *PericopesRequest) (*PericopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pericopes not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) Register(context.Context, *RegisterRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) Login(context.Context, *LoginRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) Me(context.Context, *MeRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Me not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) Enroll(context.Context, *EnrollRequest) (*EnrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enroll not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) MarkComplete(context.Context, *MarkCompleteRequest) (*PlanProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkComplete not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) Progress(context.Context, *PlanProgressRequest) (*PlanProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Progress not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) CatchUp(context.Context, *CatchUpRequest) (*CatchUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CatchUp not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) CreateAnnotation(context.Context, *AnnotationRequest) (*AnnotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnotation not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) UpdateAnnotation(context.Context, *AnnotationRequest) (*AnnotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnnotation not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) DeleteAnnotation(context.Context, *DeleteAnnotationRequest) (*DeleteAnnotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnnotation not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) ListAnnotations(context.Context, *ListAnnotationsRequest) (*ListAnnotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnotations not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) VerseOfTheDay(context.Context, *VerseOfTheDayRequest) (*VerseOfTheDayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerseOfTheDay not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) AddMemoryVerse(context.Context, *AddMemoryVerseRequest) (*MemoryCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMemoryVerse not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) RemoveMemoryVerse(context.Context, *RemoveMemoryVerseRequest) (*RemoveMemoryVerseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMemoryVerse not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) MemoryDeck(context.Context, *MemoryDeckRequest) (*MemoryDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemoryDeck not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) MemoryPrompt(context.Context, *MemoryPromptRequest) (*MemoryPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemoryPrompt not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) ReviewMemoryVerse(context.Context, *ReviewMemoryVerseRequest) (*ReviewMemoryVerseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewMemoryVerse not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) MemoryProgress(context.Context, *MemoryProgressRequest) (*MemoryProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemoryProgress not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) WordSearchPuzzle(context.Context, *WordSearchPuzzleRequest) (*WordSearchPuzzleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WordSearchPuzzle not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) Quiz(context.Context, *QuizRequest) (*QuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quiz not implemented")
+}
+func (UnimplementedWordsearcherServiceServer) CheckQuiz(context.Context, *CheckQuizRequest) (*CheckQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckQuiz not implemented")
+}
 func (UnimplementedWordsearcherServiceServer) mustEmbedUnimplementedWordsearcherServiceServer() {}
 
 // UnsafeWordsearcherServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -230,6 +642,60 @@ func _WordsearcherService_BiblePlanDay_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WordsearcherService_ImportPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).ImportPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/ImportPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).ImportPlan(ctx, req.(*ImportPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_ExportPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).ExportPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/ExportPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).ExportPlan(ctx, req.(*ExportPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_GeneratePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).GeneratePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/GeneratePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).GeneratePlan(ctx, req.(*GeneratePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WordsearcherService_BookRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BookRangeRequest)
 	if err := dec(in); err != nil {
@@ -284,29 +750,491 @@ func _WordsearcherService_CustomRange_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-// WordsearcherService_ServiceDesc is the grpc.ServiceDesc for WordsearcherService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var WordsearcherService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "wordsearcher.WordsearcherService",
-	HandlerType: (*WordsearcherServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Verse",
-			Handler:    _WordsearcherService_Verse_Handler,
-		},
-		{
-			MethodName: "Search",
-			Handler:    _WordsearcherService_Search_Handler,
-		},
-		{
-			MethodName: "BiblePlan",
-			Handler:    _WordsearcherService_BiblePlan_Handler,
-		},
-		{
-			MethodName: "BiblePlanDay",
+func _WordsearcherService_CrossReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrossReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).CrossReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/CrossReferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).CrossReferences(ctx, req.(*CrossReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_Lexicon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LexiconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).Lexicon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/Lexicon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).Lexicon(ctx, req.(*LexiconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_Interlinear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InterlinearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).Interlinear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/Interlinear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).Interlinear(ctx, req.(*InterlinearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_Pericopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PericopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).Pericopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/Pericopes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).Pericopes(ctx, req.(*PericopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_Me_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).Me(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/Me",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).Me(ctx, req.(*MeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_Enroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).Enroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/Enroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).Enroll(ctx, req.(*EnrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_MarkComplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkCompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).MarkComplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/MarkComplete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).MarkComplete(ctx, req.(*MarkCompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_Progress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).Progress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/Progress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).Progress(ctx, req.(*PlanProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_CatchUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatchUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).CatchUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/CatchUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).CatchUp(ctx, req.(*CatchUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_CreateAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).CreateAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/CreateAnnotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).CreateAnnotation(ctx, req.(*AnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_UpdateAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).UpdateAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/UpdateAnnotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).UpdateAnnotation(ctx, req.(*AnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_DeleteAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).DeleteAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/DeleteAnnotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).DeleteAnnotation(ctx, req.(*DeleteAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_ListAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).ListAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/ListAnnotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).ListAnnotations(ctx, req.(*ListAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_VerseOfTheDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerseOfTheDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).VerseOfTheDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/VerseOfTheDay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).VerseOfTheDay(ctx, req.(*VerseOfTheDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_AddMemoryVerse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMemoryVerseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).AddMemoryVerse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/AddMemoryVerse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).AddMemoryVerse(ctx, req.(*AddMemoryVerseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_RemoveMemoryVerse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemoryVerseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).RemoveMemoryVerse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/RemoveMemoryVerse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).RemoveMemoryVerse(ctx, req.(*RemoveMemoryVerseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_MemoryDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemoryDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).MemoryDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/MemoryDeck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).MemoryDeck(ctx, req.(*MemoryDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_MemoryPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemoryPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).MemoryPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/MemoryPrompt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).MemoryPrompt(ctx, req.(*MemoryPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_ReviewMemoryVerse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewMemoryVerseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).ReviewMemoryVerse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/ReviewMemoryVerse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).ReviewMemoryVerse(ctx, req.(*ReviewMemoryVerseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_MemoryProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemoryProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).MemoryProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/MemoryProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).MemoryProgress(ctx, req.(*MemoryProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_WordSearchPuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WordSearchPuzzleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).WordSearchPuzzle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/WordSearchPuzzle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).WordSearchPuzzle(ctx, req.(*WordSearchPuzzleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_Quiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).Quiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/Quiz",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).Quiz(ctx, req.(*QuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordsearcherService_CheckQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordsearcherServiceServer).CheckQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearcher.WordsearcherService/CheckQuiz",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordsearcherServiceServer).CheckQuiz(ctx, req.(*CheckQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WordsearcherService_ServiceDesc is the grpc.ServiceDesc for WordsearcherService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WordsearcherService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordsearcher.WordsearcherService",
+	HandlerType: (*WordsearcherServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Verse",
+			Handler:    _WordsearcherService_Verse_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _WordsearcherService_Search_Handler,
+		},
+		{
+			MethodName: "BiblePlan",
+			Handler:    _WordsearcherService_BiblePlan_Handler,
+		},
+		{
+			MethodName: "BiblePlanDay",
 			Handler:    _WordsearcherService_BiblePlanDay_Handler,
 		},
+		{
+			MethodName: "ImportPlan",
+			Handler:    _WordsearcherService_ImportPlan_Handler,
+		},
+		{
+			MethodName: "ExportPlan",
+			Handler:    _WordsearcherService_ExportPlan_Handler,
+		},
+		{
+			MethodName: "GeneratePlan",
+			Handler:    _WordsearcherService_GeneratePlan_Handler,
+		},
 		{
 			MethodName: "BookRange",
 			Handler:    _WordsearcherService_BookRange_Handler,
@@ -319,6 +1247,106 @@ var WordsearcherService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CustomRange",
 			Handler:    _WordsearcherService_CustomRange_Handler,
 		},
+		{
+			MethodName: "CrossReferences",
+			Handler:    _WordsearcherService_CrossReferences_Handler,
+		},
+		{
+			MethodName: "Lexicon",
+			Handler:    _WordsearcherService_Lexicon_Handler,
+		},
+		{
+			MethodName: "Interlinear",
+			Handler:    _WordsearcherService_Interlinear_Handler,
+		},
+		{
+			MethodName: "Pericopes",
+			Handler:    _WordsearcherService_Pericopes_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _WordsearcherService_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _WordsearcherService_Login_Handler,
+		},
+		{
+			MethodName: "Me",
+			Handler:    _WordsearcherService_Me_Handler,
+		},
+		{
+			MethodName: "Enroll",
+			Handler:    _WordsearcherService_Enroll_Handler,
+		},
+		{
+			MethodName: "MarkComplete",
+			Handler:    _WordsearcherService_MarkComplete_Handler,
+		},
+		{
+			MethodName: "Progress",
+			Handler:    _WordsearcherService_Progress_Handler,
+		},
+		{
+			MethodName: "CatchUp",
+			Handler:    _WordsearcherService_CatchUp_Handler,
+		},
+		{
+			MethodName: "CreateAnnotation",
+			Handler:    _WordsearcherService_CreateAnnotation_Handler,
+		},
+		{
+			MethodName: "UpdateAnnotation",
+			Handler:    _WordsearcherService_UpdateAnnotation_Handler,
+		},
+		{
+			MethodName: "DeleteAnnotation",
+			Handler:    _WordsearcherService_DeleteAnnotation_Handler,
+		},
+		{
+			MethodName: "ListAnnotations",
+			Handler:    _WordsearcherService_ListAnnotations_Handler,
+		},
+		{
+			MethodName: "VerseOfTheDay",
+			Handler:    _WordsearcherService_VerseOfTheDay_Handler,
+		},
+		{
+			MethodName: "AddMemoryVerse",
+			Handler:    _WordsearcherService_AddMemoryVerse_Handler,
+		},
+		{
+			MethodName: "RemoveMemoryVerse",
+			Handler:    _WordsearcherService_RemoveMemoryVerse_Handler,
+		},
+		{
+			MethodName: "MemoryDeck",
+			Handler:    _WordsearcherService_MemoryDeck_Handler,
+		},
+		{
+			MethodName: "MemoryPrompt",
+			Handler:    _WordsearcherService_MemoryPrompt_Handler,
+		},
+		{
+			MethodName: "ReviewMemoryVerse",
+			Handler:    _WordsearcherService_ReviewMemoryVerse_Handler,
+		},
+		{
+			MethodName: "MemoryProgress",
+			Handler:    _WordsearcherService_MemoryProgress_Handler,
+		},
+		{
+			MethodName: "WordSearchPuzzle",
+			Handler:    _WordsearcherService_WordSearchPuzzle_Handler,
+		},
+		{
+			MethodName: "Quiz",
+			Handler:    _WordsearcherService_Quiz_Handler,
+		},
+		{
+			MethodName: "CheckQuiz",
+			Handler:    _WordsearcherService_CheckQuiz_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "wspb/ws.proto",